@@ -0,0 +1,7 @@
+{{/*
+Labels of all resources of the application
+*/}}
+{{- define "app.labels" -}}
+app: {{ .Values.app.name | quote }}
+release: {{ .Values.app.version | quote }}
+{{- end }}
@@ -2,10 +2,14 @@ package main
 
 import (
 	"bufio"
+	"bytes"
+	"context"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"os"
+	"path"
 	"strconv"
 	"strings"
 
@@ -16,11 +20,16 @@ import (
 	"deploy-wizard/gen/restapi/operations/general"
 	"deploy-wizard/gen/restapi/operations/validations"
 	"deploy-wizard/pkg/application"
+	"deploy-wizard/pkg/archive"
+	"deploy-wizard/pkg/config"
 	"deploy-wizard/pkg/git"
 	"deploy-wizard/pkg/metrics"
+	"deploy-wizard/pkg/policy"
+	"deploy-wizard/pkg/schema"
 
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 )
@@ -30,9 +39,12 @@ const (
 	envPasswordVar            = "KRUISE_GIT_PASSWORD"
 	codeRenderError           = 101
 	codeDeploySpecRenderError = 102
+	codeBundleError           = 103
+	codePolicyError           = 104
 	codeRepoCloneError        = 301
 	codeRepoCommitError       = 302
 	codeRepoPushError         = 303
+	codeRepoReadError         = 304
 )
 
 func main() {
@@ -44,6 +56,12 @@ func main() {
 		stashPasswordFile     string
 		stashPassword         string
 		gitInsecureSkipVerify bool
+		configFile            string
+		templateDir           string
+		teamTemplateDir       string
+		kubernetesVersion     string
+		renderMode            string
+		policyDir             string
 	)
 
 	var portFlag = flag.Int("port", 9801, "Port to run this service on")
@@ -51,6 +69,12 @@ func main() {
 	flag.BoolVar(&gitInsecureSkipVerify, "git-insecure-skip-verify", false, "If true, will ignore TLS verification errors (insecure)")
 	flag.StringVar(&stashUserFile, "username-file", "", "Path to a file that contains the stash username")
 	flag.StringVar(&stashPasswordFile, "password-file", "", "Path to a file that contains the stash password")
+	flag.StringVar(&configFile, "config", "", "Path to a YAML file with the server configuration (clusters, environments)")
+	flag.StringVar(&templateDir, "template-dir", "", "Path to a directory with templates that replace the embedded ones, reloaded when it changes")
+	flag.StringVar(&kubernetesVersion, "kubernetes-version", schema.DefaultVersion, fmt.Sprintf("Kubernetes version of the clusters and envs that do not configure one (%s)", strings.Join(schema.Versions(), ", ")))
+	flag.StringVar(&renderMode, "render-mode", string(application.RenderModeTemplate), "How Deployments, Services, Ingresses, ConfigMaps and PVCs are rendered: template executes their templates, typed builds them and merges the patches/ templates into them")
+	flag.StringVar(&teamTemplateDir, "team-template-dir", "", "Path to a directory with a directory of templates per team that replace the embedded and template-dir ones, reloaded when it changes")
+	flag.StringVar(&policyDir, "policy-dir", "", "Path to a directory with Rego policies whose deny rules block a release and whose warn rules are reported, evaluated against the rendered manifests and deploy specs")
 
 	// parse flags
 	flag.Parse()
@@ -81,6 +105,11 @@ func main() {
 		}
 	}
 
+	cfg, err := config.Load(configFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	swaggerSpec, err := loads.Analyzed(restapi.SwaggerJSON, "")
 	if err != nil {
 		log.Fatal(err)
@@ -97,8 +126,35 @@ func main() {
 	// set the port this service will be run on
 	server.Port = *portFlag
 
-	// TODO: flag template directory
-	renderer, err := application.NewRenderer("./_templates")
+	var policies *policy.Engine
+	if policyDir != "" {
+		policies, err = policy.Load(policyDir)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	renderer, err := application.NewRenderer(templateDir,
+		application.WithClusters(cfg.Clusters),
+		application.WithEnvironments(cfg.Environments),
+		application.WithTeamTemplates(teamTemplateDir),
+		application.WithKubernetesVersion(kubernetesVersion),
+		application.WithRenderMode(application.RenderMode(renderMode)),
+		application.WithPolicies(policies),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	stopWatching := make(chan struct{})
+	defer close(stopWatching)
+	err = renderer.WatchTemplates(stopWatching, func(err error) {
+		if err != nil {
+			metrics.TemplateReloadCount.WithLabelValues("failure").Inc()
+			return
+		}
+		metrics.TemplateReloadCount.WithLabelValues("success").Inc()
+	})
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -108,10 +164,44 @@ func main() {
 			return general.NewGetHealthOK().WithPayload(&models.HealthStatus{Status: "OK"})
 		})
 
+	api.GeneralListTemplatesHandler = general.ListTemplatesHandlerFunc(
+		func(params general.ListTemplatesParams) middleware.Responder {
+			var team string
+			if params.Team != nil {
+				team = *params.Team
+			}
+			return general.NewListTemplatesOK().WithPayload(&models.TemplateListResponse{Templates: renderer.Templates(team)})
+		})
+
 	api.ValidationsValidateApplicationHandler = validations.ValidateApplicationHandlerFunc(
 		func(params validations.ValidateApplicationParams) middleware.Responder {
-			validationErrors := application.ValidateApplication(params.Application)
-			return validations.NewValidateApplicationOK().WithPayload(&models.ValidationResponse{Errors: validationErrors})
+			findings := application.CheckApplication(params.Application,
+				application.RequireCluster(cfg.Clusters),
+				application.EnforcePolicies(cfg.Policies),
+				application.PromoteWarnings(cfg.Environments),
+			)
+
+			// the manifests of a valid application are checked like a release checks them
+			if len(findings.Errors) == 0 {
+				var app *models.Application
+				if err := swag.DynamicJSONToStruct(params.Application, &app); err != nil {
+					errResp := &models.Error{Code: codeRenderError, Message: err.Error()}
+					return validations.NewValidateApplicationDefault(500).WithPayload(errResp)
+				}
+				app = application.ApplyDefaults(app)
+
+				rendered, err := renderer.RenderManifests(app)
+				if err != nil {
+					errResp := &models.Error{Code: codeRenderError, Message: err.Error()}
+					return validations.NewValidateApplicationDefault(500).WithPayload(errResp)
+				}
+				if err := renderer.CheckManifests(params.HTTPRequest.Context(), app, rendered, findings); err != nil {
+					errResp := &models.Error{Code: codePolicyError, Message: err.Error()}
+					return validations.NewValidateApplicationDefault(500).WithPayload(errResp)
+				}
+			}
+
+			return validations.NewValidateApplicationOK().WithPayload(application.NewFindingsResponse(findings))
 		})
 
 	api.AppsPreviewAppHandler = apps.PreviewAppHandlerFunc(
@@ -122,6 +212,12 @@ func main() {
 
 			app := application.ApplyDefaults(params.Application)
 
+			// every warning is reported, including those the env promotes to errors
+			findings := application.CheckApplication(app)
+			if len(findings.Errors) > 0 {
+				return apps.NewPreviewAppBadRequest().WithPayload(issuesMessage(findings.Errors))
+			}
+
 			rendered, err := renderer.RenderApplication(app)
 			if err != nil {
 				errResp := &models.Error{Code: codeRenderError, Message: err.Error()}
@@ -129,37 +225,93 @@ func main() {
 			}
 
 			metrics.AppsRenderedCount.WithLabelValues(app.Metadata.Name).Inc()
-			return apps.NewPreviewAppCreated().WithPayload(rendered)
+			return apps.NewPreviewAppCreated().
+				WithWarning(warningHeader(findings.Warnings)).
+				WithPayload(rendered)
 		})
 
-	api.AppsReleaseAppHandler = apps.ReleaseAppHandlerFunc(
-		func(params apps.ReleaseAppParams) middleware.Responder {
+	api.AppsPreviewAppFilesHandler = apps.PreviewAppFilesHandlerFunc(
+		func(params apps.PreviewAppFilesParams) middleware.Responder {
 			if params.Application == nil {
-				return apps.NewReleaseAppBadRequest().WithPayload(&models.ValidationResponse{
-					Errors: map[string]interface{}{
-						"application": fmt.Sprintf("%q is required", "application"),
-					},
-				})
+				return apps.NewPreviewAppFilesBadRequest().WithPayload(application.NewValidationResponse(application.RequiredErrors("application")))
 			}
 
 			app := application.ApplyDefaults(params.Application)
-			validationErrors := application.ValidateApplication(app)
+			if findings := application.CheckApplication(app, application.RequireCluster(cfg.Clusters)); len(findings.Errors) > 0 {
+				return apps.NewPreviewAppFilesBadRequest().WithPayload(application.NewFindingsResponse(findings))
+			}
+
+			preview, err := renderer.RenderPreview(app)
+			if err != nil {
+				errResp := &models.Error{Code: codeRenderError, Message: err.Error()}
+				return apps.NewPreviewAppFilesDefault(500).WithPayload(errResp)
+			}
 
-			if len(validationErrors) > 0 {
-				return apps.NewReleaseAppBadRequest().WithPayload(&models.ValidationResponse{Errors: validationErrors})
+			metrics.AppsRenderedCount.WithLabelValues(app.Metadata.Name).Inc()
+			return apps.NewPreviewAppFilesCreated().WithPayload(preview)
+		})
+
+	api.AppsPreviewAppDiffHandler = apps.PreviewAppDiffHandlerFunc(
+		func(params apps.PreviewAppDiffParams) middleware.Responder {
+			if params.Application == nil {
+				return apps.NewPreviewAppDiffBadRequest().WithPayload(application.NewValidationResponse(application.RequiredErrors("application")))
 			}
 
-			rendered, err := renderer.RenderManifests(app)
+			app := application.ApplyDefaults(params.Application)
+			if findings := application.CheckApplication(app, application.RequireCluster(cfg.Clusters)); len(findings.Errors) > 0 {
+				return apps.NewPreviewAppDiffBadRequest().WithPayload(application.NewFindingsResponse(findings))
+			}
+
+			repo := git.NewRepo(
+				app.Spec.Destination.URL.String(),
+				app.Spec.Destination.Path,
+				app.Spec.Destination.TargetRevision,
+				&git.RepoCreds{
+					Username: stashUser,
+					Password: stashPassword,
+				}, gitInsecureSkipVerify)
+
+			if err := repo.Clone(); err != nil {
+				errResp := &models.Error{Code: codeRepoCloneError, Message: err.Error()}
+				return apps.NewPreviewAppDiffDefault(500).WithPayload(errResp)
+			}
+
+			files, err := renderer.RenderBundle(app, repo.ReadFile)
 			if err != nil {
 				errResp := &models.Error{Code: codeRenderError, Message: err.Error()}
-				return apps.NewReleaseAppDefault(500).WithPayload(errResp)
+				return apps.NewPreviewAppDiffDefault(500).WithPayload(errResp)
 			}
 
-			renderedDeploySpec, err := renderer.RenderDeploySpec(app)
+			recorded, err := application.LoadFiles(app.Spec.Destination.Path, repo.ReadFile)
 			if err != nil {
-				errResp := &models.Error{Code: codeDeploySpecRenderError, Message: err.Error()}
+				errResp := &models.Error{Code: codeRepoReadError, Message: err.Error()}
+				return apps.NewPreviewAppDiffDefault(500).WithPayload(errResp)
+			}
+
+			stale := application.StaleFiles(app, files, recorded)
+			diffs, err := application.Diff(files, stale, app.Spec.Destination.PruneFiles, repo.ReadFile)
+			if err != nil {
+				errResp := &models.Error{Code: codeRepoReadError, Message: err.Error()}
+				return apps.NewPreviewAppDiffDefault(500).WithPayload(errResp)
+			}
+
+			return apps.NewPreviewAppDiffCreated().WithPayload(&models.PreviewDiffResponse{Files: diffs})
+		})
+
+	api.AppsReleaseAppHandler = apps.ReleaseAppHandlerFunc(
+		func(params apps.ReleaseAppParams) middleware.Responder {
+			if params.Application == nil {
+				return apps.NewReleaseAppBadRequest().WithPayload(application.NewValidationResponse(application.RequiredErrors("application")))
+			}
+
+			app := application.ApplyDefaults(params.Application)
+			rendered, findings, errResp := renderRelease(params.HTTPRequest.Context(), renderer, cfg, app)
+			if errResp != nil {
 				return apps.NewReleaseAppDefault(500).WithPayload(errResp)
 			}
+			if len(findings.Errors) > 0 {
+				return apps.NewReleaseAppBadRequest().WithPayload(application.NewFindingsResponse(findings))
+			}
 
 			repo := git.NewRepo(
 				app.Spec.Destination.URL.String(),
@@ -175,15 +327,55 @@ func main() {
 				errResp := &models.Error{Code: codeRepoCloneError, Message: err.Error()}
 				return apps.NewReleaseAppDefault(500).WithPayload(errResp)
 			}
+			if !repo.IsBranch() {
+				return apps.NewReleaseAppBadRequest().WithPayload(application.NewValidationResponse(application.NotABranchErrors(app.Spec.Destination)))
+			}
+
+			if err := application.AddReleaseRecord(app, rendered); err != nil {
+				errResp := &models.Error{Code: codeRenderError, Message: err.Error()}
+				return apps.NewReleaseAppDefault(500).WithPayload(errResp)
+			}
 
 			for filename, content := range rendered {
 				log.Infof("adding file %q (%d bytes)", filename, len(content))
 				repo.AddFile(filename, content)
 			}
 
-			deploySpecFile := fmt.Sprintf("apps/%s.yaml", strings.ToLower(app.Metadata.Name))
-			log.Infof("adding deploy spec %q (%d bytes)", deploySpecFile, len(renderedDeploySpec))
-			repo.AddDeploySpec(deploySpecFile, renderedDeploySpec)
+			renderedDeploySpecs, err := renderer.RenderDeploySpecs(app, repo.ReadFile)
+			if err != nil {
+				errResp := &models.Error{Code: codeDeploySpecRenderError, Message: err.Error()}
+				return apps.NewReleaseAppDefault(500).WithPayload(errResp)
+			}
+
+			for filename, content := range renderedDeploySpecs {
+				log.Infof("adding deploy spec %q (%d bytes)", filename, len(content))
+				if err := repo.AddDeploySpec(filename, content); err != nil {
+					errResp := &models.Error{Code: codeRepoCommitError, Message: err.Error()}
+					return apps.NewReleaseAppDefault(500).WithPayload(errResp)
+				}
+			}
+
+			if app.Spec.Destination.PruneFiles {
+				recorded, err := application.LoadFiles(app.Spec.Destination.Path, repo.ReadFile)
+				if err != nil {
+					errResp := &models.Error{Code: codeRepoReadError, Message: err.Error()}
+					return apps.NewReleaseAppDefault(500).WithPayload(errResp)
+				}
+
+				prefix := strings.TrimPrefix(app.Spec.Destination.Path, "/")
+				files := map[string]string{}
+				for filename, content := range rendered {
+					files[path.Join(prefix, filename)] = content
+				}
+				for _, filename := range application.StaleFiles(app, files, recorded) {
+					if _, err := repo.ReadFile(filename); os.IsNotExist(err) {
+						// already removed by hand
+						continue
+					}
+					log.Infof("removing stale file %q", filename)
+					repo.RemoveFile(filename)
+				}
+			}
 
 			err = repo.Commit(
 				fmt.Sprintf("kruise release for %s:%s",
@@ -201,7 +393,115 @@ func main() {
 				return apps.NewReleaseAppDefault(500).WithPayload(errResp)
 			}
 
-			return apps.NewReleaseAppCreated().WithPayload(&models.ValidationResponse{Errors: validationErrors})
+			return apps.NewReleaseAppCreated().WithPayload(application.NewFindingsResponse(findings))
+		})
+
+	api.AppsBundleAppHandler = apps.BundleAppHandlerFunc(
+		func(params apps.BundleAppParams) middleware.Responder {
+			if params.Application == nil {
+				return apps.NewBundleAppBadRequest().WithPayload(application.NewValidationResponse(application.RequiredErrors("application")))
+			}
+
+			// a bundle holds what a release would commit, so it passes the same checks
+			app := application.ApplyDefaults(params.Application)
+			_, findings, errResp := renderRelease(params.HTTPRequest.Context(), renderer, cfg, app)
+			if errResp != nil {
+				return apps.NewBundleAppDefault(500).WithPayload(errResp)
+			}
+			if len(findings.Errors) > 0 {
+				return apps.NewBundleAppBadRequest().WithPayload(application.NewFindingsResponse(findings))
+			}
+
+			files, err := renderer.RenderBundle(app, nil)
+			if err != nil {
+				errResp := &models.Error{Code: codeRenderError, Message: err.Error()}
+				return apps.NewBundleAppDefault(500).WithPayload(errResp)
+			}
+
+			var bundle bytes.Buffer
+			if err := archive.Write(&bundle, *params.Archive, files); err != nil {
+				errResp := &models.Error{Code: codeBundleError, Message: err.Error()}
+				return apps.NewBundleAppDefault(500).WithPayload(errResp)
+			}
+
+			metrics.AppsRenderedCount.WithLabelValues(app.Metadata.Name).Inc()
+			filename := fmt.Sprintf("%s-%s.%s", app.Metadata.Name, app.Metadata.Labels.Version, *params.Archive)
+			return apps.NewBundleAppOK().
+				WithContentDisposition(fmt.Sprintf("attachment; filename=%q", filename)).
+				WithPayload(ioutil.NopCloser(&bundle))
+		})
+
+	api.AppsImportAppHandler = apps.ImportAppHandlerFunc(
+		func(params apps.ImportAppParams) middleware.Responder {
+			source := params.Source
+			if source == nil || (source.Manifests == "" && source.Repository == nil) {
+				return apps.NewImportAppBadRequest().WithPayload("manifests or repository is required")
+			}
+
+			if source.Manifests != "" {
+				imported, err := application.ImportManifests(source.Manifests)
+				if err != nil {
+					return apps.NewImportAppBadRequest().WithPayload(err.Error())
+				}
+				return apps.NewImportAppOK().WithPayload(imported)
+			}
+
+			repo := git.NewRepo(
+				source.Repository.URL.String(),
+				source.Repository.Path,
+				source.Repository.TargetRevision,
+				&git.RepoCreds{
+					Username: stashUser,
+					Password: stashPassword,
+				}, gitInsecureSkipVerify)
+
+			if err := repo.Clone(); err != nil {
+				errResp := &models.Error{Code: codeRepoCloneError, Message: err.Error()}
+				return apps.NewImportAppDefault(500).WithPayload(errResp)
+			}
+
+			imported, err := application.ImportKustomization(source.Repository.Path, repo.ReadFile)
+			if err != nil {
+				return apps.NewImportAppBadRequest().WithPayload(err.Error())
+			}
+
+			// the application is released back to where it was imported from
+			destination := imported.Application.Spec.Destination
+			destination.URL = source.Repository.URL
+			destination.Path = source.Repository.Path
+			destination.TargetRevision = source.Repository.TargetRevision
+
+			return apps.NewImportAppOK().WithPayload(imported)
+		})
+
+	api.AppsLoadAppHandler = apps.LoadAppHandlerFunc(
+		func(params apps.LoadAppParams) middleware.Responder {
+			destinationPath := swag.StringValue(params.Path)
+			repo := git.NewRepo(
+				params.URL.String(),
+				destinationPath,
+				swag.StringValue(params.TargetRevision),
+				&git.RepoCreds{
+					Username: stashUser,
+					Password: stashPassword,
+				}, gitInsecureSkipVerify)
+
+			if err := repo.Clone(); err != nil {
+				errResp := &models.Error{Code: codeRepoCloneError, Message: err.Error()}
+				return apps.NewLoadAppDefault(500).WithPayload(errResp)
+			}
+
+			app, err := application.LoadSpec(destinationPath, repo.ReadFile)
+			if os.IsNotExist(err) {
+				return apps.NewLoadAppNotFound().WithPayload(
+					fmt.Sprintf("no application committed at %q", path.Join("/", destinationPath, application.SpecFile)))
+			}
+			if err != nil {
+				errResp := &models.Error{Code: codeRepoReadError, Message: err.Error()}
+				return apps.NewLoadAppDefault(500).WithPayload(errResp)
+			}
+
+			return apps.NewLoadAppOK().WithPayload(app)
 		})
 
 	server.ConfigureAPI()
@@ -228,3 +528,52 @@ func loadFromFile(filename string) string {
 	s.Scan()
 	return s.Text()
 }
+
+// renderRelease validates an application and renders and checks its manifests
+// the way a release does: against the schemas of its Kubernetes version and
+// the Rego policies. The errors of the findings prevent the release; an error
+// rendering or checking the manifests is returned as the error response.
+func renderRelease(ctx context.Context, renderer *application.Renderer, cfg *config.Config, app *models.Application) (map[string]string, *application.Findings, *models.Error) {
+	findings := application.CheckApplication(app,
+		application.RequireCluster(cfg.Clusters),
+		application.EnforcePolicies(cfg.Policies),
+		application.PromoteWarnings(cfg.Environments),
+	)
+	if len(findings.Errors) > 0 {
+		return nil, findings, nil
+	}
+
+	rendered, err := renderer.RenderManifests(app)
+	if err != nil {
+		return nil, findings, &models.Error{Code: codeRenderError, Message: err.Error()}
+	}
+
+	// a release the schemas or the Rego policies reject never reaches the repository
+	if err := renderer.CheckManifests(ctx, app, rendered, findings); err != nil {
+		return nil, findings, &models.Error{Code: codePolicyError, Message: err.Error()}
+	}
+	return rendered, findings, nil
+}
+
+// issuesMessage joins the paths and messages of validation errors, one per
+// line, for the responses that are plain text
+func issuesMessage(errors map[string]interface{}) string {
+	var lines []string
+	for _, issue := range application.Issues(errors, models.ValidationIssueSeverityError) {
+		lines = append(lines, fmt.Sprintf("%s: %s", issue.Path, issue.Message))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// warningHeader returns the warnings as the value of a Warning header: one
+// RFC 7234 warn-value with the miscellaneous persistent warning code 299 for
+// each warning, ordered by the path of its field
+func warningHeader(warnings map[string]interface{}) string {
+	quote := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+	var values []string
+	for _, issue := range application.Issues(warnings, models.ValidationIssueSeverityWarning) {
+		values = append(values, fmt.Sprintf(`299 - "%s"`, quote.Replace(issue.Message)))
+	}
+	return strings.Join(values, ", ")
+}
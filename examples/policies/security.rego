@@ -0,0 +1,28 @@
+# The server evaluates the Rego modules of --policy-dir against each resource
+# the application deploys to an env, with the overlay or values of the env
+# applied, and against its deploy specs, e.g. the ArgoCD Application and
+# AppProject, with the resource as input. The deny and warn rules of every
+# package must be sets of messages, strings or objects with a msg field: a
+# deny message rejects the release, a warn message is reported with it. Files
+# ending in _test.rego are skipped.
+package kubernetes.security
+
+deny[msg] {
+	input.kind == "Deployment"
+	container := input.spec.template.spec.containers[_]
+	container.securityContext.privileged
+	msg := sprintf("container %s must not be privileged", [container.name])
+}
+
+deny[msg] {
+	input.kind == "Application"
+	input.spec.destination.namespace == "kube-system"
+	msg := "applications are not deployed to kube-system"
+}
+
+warn[msg] {
+	input.kind == "Deployment"
+	container := input.spec.template.spec.containers[_]
+	not container.securityContext.runAsNonRoot
+	msg := sprintf("container %s may run as root", [container.name])
+}
@@ -26,6 +26,10 @@ type Component struct {
 	// ingresses
 	Ingresses []*Ingress `json:"ingresses"`
 
+	// The number of pods to run. Default is 1
+	// Minimum: 1
+	Replicas int64 `json:"replicas,omitempty"`
+
 	// service
 	// Required: true
 	Service *Service `json:"service"`
@@ -43,6 +47,10 @@ func (m *Component) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateReplicas(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateService(formats); err != nil {
 		res = append(res, err)
 	}
@@ -103,6 +111,19 @@ func (m *Component) validateIngresses(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Component) validateReplicas(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Replicas) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("replicas", "body", int64(m.Replicas), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Component) validateService(formats strfmt.Registry) error {
 
 	if err := validate.Required("service", "body", m.Service); err != nil {
@@ -21,7 +21,7 @@ type ConfigMap struct {
 	// Min Length: 1
 	Data string `json:"data,omitempty"`
 
-	// The name of the ConfigMap
+	// The name of the ConfigMap, which also names its volume. A DNS label (RFC 1123)
 	// Required: true
 	// Min Length: 1
 	Name string `json:"name"`
@@ -39,7 +39,10 @@ type Container struct {
 	// Min Length: 1
 	ImageTag string `json:"imageTag"`
 
-	// The name of this container within the service
+	// liveness probe
+	LivenessProbe *Probe `json:"livenessProbe,omitempty"`
+
+	// The name of this container within the service. A DNS label (RFC 1123)
 	// Required: true
 	// Min Length: 1
 	Name string `json:"name"`
@@ -48,6 +51,12 @@ type Container struct {
 	// Required: true
 	PortNames []string `json:"portNames"`
 
+	// readiness probe
+	ReadinessProbe *Probe `json:"readinessProbe,omitempty"`
+
+	// resources
+	Resources *ResourceRequirements `json:"resources,omitempty"`
+
 	// volumes
 	Volumes []*VolumeMount `json:"volumes"`
 }
@@ -68,6 +77,10 @@ func (m *Container) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateLivenessProbe(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -76,6 +89,14 @@ func (m *Container) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateReadinessProbe(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateResources(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateVolumes(formats); err != nil {
 		res = append(res, err)
 	}
@@ -159,6 +180,24 @@ func (m *Container) validateImageTag(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Container) validateLivenessProbe(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LivenessProbe) { // not required
+		return nil
+	}
+
+	if m.LivenessProbe != nil {
+		if err := m.LivenessProbe.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("livenessProbe")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Container) validateName(formats strfmt.Registry) error {
 
 	if err := validate.RequiredString("name", "body", string(m.Name)); err != nil {
@@ -181,6 +220,42 @@ func (m *Container) validatePortNames(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Container) validateReadinessProbe(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ReadinessProbe) { // not required
+		return nil
+	}
+
+	if m.ReadinessProbe != nil {
+		if err := m.ReadinessProbe.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("readinessProbe")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Container) validateResources(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Resources) { // not required
+		return nil
+	}
+
+	if m.Resources != nil {
+		if err := m.Resources.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("resources")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Container) validateVolumes(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Volumes) { // not required
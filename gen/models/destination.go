@@ -6,6 +6,9 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
+	"strconv"
+
 	strfmt "github.com/go-openapi/strfmt"
 
 	"github.com/go-openapi/errors"
@@ -17,10 +20,30 @@ import (
 // swagger:model destination
 type Destination struct {
 
+	// The continuous delivery tool that deploys the application. Defaults to the deploy target of the env, or argocd.
+	// Enum: [argocd flux]
+	DeployTarget string `json:"deployTarget,omitempty"`
+
+	// Finalizers set on the ArgoCD Application, e.g. resources-finalizer.argocd.argoproj.io to cascade deletes
+	Finalizers []string `json:"finalizers"`
+
+	// The format the manifests are rendered in, a Kustomize base with overlays or a Helm chart. Defaults to kustomize.
+	// Enum: [kustomize helm]
+	Format string `json:"format,omitempty"`
+
+	// Resource fields ArgoCD ignores when comparing live and desired state
+	IgnoreDifferences []*IgnoreDifference `json:"ignoreDifferences"`
+
 	// The relative path to the manifests in the git repo
 	// Min Length: 1
 	Path string `json:"path,omitempty"`
 
+	// Whether a release removes the files the previous release committed under the path and this one no longer renders. Other files under the path are never removed.
+	PruneFiles bool `json:"pruneFiles,omitempty"`
+
+	// sync policy
+	SyncPolicy *SyncPolicy `json:"syncPolicy,omitempty"`
+
 	// Defines the commit, tag, or branch in which to sync the application to.
 	// Min Length: 1
 	TargetRevision string `json:"targetRevision,omitempty"`
@@ -36,10 +59,30 @@ type Destination struct {
 func (m *Destination) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateDeployTarget(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFinalizers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFormat(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIgnoreDifferences(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePath(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateSyncPolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTargetRevision(formats); err != nil {
 		res = append(res, err)
 	}
@@ -54,6 +97,134 @@ func (m *Destination) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+var destinationTypeDeployTargetPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["argocd","flux"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		destinationTypeDeployTargetPropEnum = append(destinationTypeDeployTargetPropEnum, v)
+	}
+}
+
+const (
+
+	// DestinationDeployTargetArgocd captures enum value "argocd"
+	DestinationDeployTargetArgocd string = "argocd"
+
+	// DestinationDeployTargetFlux captures enum value "flux"
+	DestinationDeployTargetFlux string = "flux"
+)
+
+// prop value enum
+func (m *Destination) validateDeployTargetEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, destinationTypeDeployTargetPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Destination) validateDeployTarget(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DeployTarget) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateDeployTargetEnum("deployTarget", "body", m.DeployTarget); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Destination) validateFinalizers(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Finalizers) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Finalizers); i++ {
+
+		if err := validate.MinLength("finalizers"+"."+strconv.Itoa(i), "body", string(m.Finalizers[i]), 1); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+var destinationTypeFormatPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["kustomize","helm"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		destinationTypeFormatPropEnum = append(destinationTypeFormatPropEnum, v)
+	}
+}
+
+const (
+
+	// DestinationFormatKustomize captures enum value "kustomize"
+	DestinationFormatKustomize string = "kustomize"
+
+	// DestinationFormatHelm captures enum value "helm"
+	DestinationFormatHelm string = "helm"
+)
+
+// prop value enum
+func (m *Destination) validateFormatEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, destinationTypeFormatPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Destination) validateFormat(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Format) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateFormatEnum("format", "body", m.Format); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Destination) validateIgnoreDifferences(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.IgnoreDifferences) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.IgnoreDifferences); i++ {
+		if swag.IsZero(m.IgnoreDifferences[i]) { // not required
+			continue
+		}
+
+		if m.IgnoreDifferences[i] != nil {
+			if err := m.IgnoreDifferences[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("ignoreDifferences" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Destination) validatePath(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Path) { // not required
@@ -67,6 +238,24 @@ func (m *Destination) validatePath(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Destination) validateSyncPolicy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SyncPolicy) { // not required
+		return nil
+	}
+
+	if m.SyncPolicy != nil {
+		if err := m.SyncPolicy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("syncPolicy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Destination) validateTargetRevision(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.TargetRevision) { // not required
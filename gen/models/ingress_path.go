@@ -17,7 +17,7 @@ import (
 // swagger:model ingressPath
 type IngressPath struct {
 
-	// Path is matched against the path of an incoming request
+	// Path is matched against the path of an incoming request. Starts with /
 	// Required: true
 	// Min Length: 1
 	Path string `json:"path"`
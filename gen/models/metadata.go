@@ -21,12 +21,12 @@ type Metadata struct {
 	// Required: true
 	Labels *Labels `json:"labels"`
 
-	// The name of the application
+	// The name of the application. A DNS label (RFC 1123)
 	// Required: true
 	// Min Length: 1
 	Name string `json:"name"`
 
-	// The namespace to deploy to
+	// The namespace to deploy to. A DNS label (RFC 1123)
 	// Required: true
 	// Min Length: 1
 	Namespace string `json:"namespace"`
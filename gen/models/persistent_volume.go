@@ -29,7 +29,7 @@ type PersistentVolume struct {
 	// Required: true
 	Capacity int64 `json:"capacity"`
 
-	// The name of the volume
+	// The name of the volume, which also names its claim. A DNS label (RFC 1123)
 	// Required: true
 	// Min Length: 1
 	Name string `json:"name"`
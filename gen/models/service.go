@@ -20,7 +20,7 @@ import (
 // swagger:model service
 type Service struct {
 
-	// The name of the service
+	// The name of the service. A DNS label (RFC 1035)
 	// Required: true
 	// Min Length: 1
 	Name string `json:"name"`
@@ -19,7 +19,7 @@ import (
 // swagger:model servicePort
 type ServicePort struct {
 
-	// The name of this port within the service
+	// The name of this port within the service. An IANA_SVC_NAME
 	// Required: true
 	// Min Length: 1
 	Name string `json:"name"`
@@ -30,6 +30,12 @@ type Spec struct {
 	// Required: true
 	Destination *Destination `json:"destination"`
 
+	// kustomize
+	Kustomize *Kustomize `json:"kustomize,omitempty"`
+
+	// Per-environment adjustments rendered as Kustomize overlays of the base manifests
+	Overlays []*Overlay `json:"overlays"`
+
 	// persistent volumes
 	PersistentVolumes []*PersistentVolume `json:"persistentVolumes"`
 }
@@ -50,6 +56,14 @@ func (m *Spec) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateKustomize(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOverlays(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePersistentVolumes(formats); err != nil {
 		res = append(res, err)
 	}
@@ -128,6 +142,49 @@ func (m *Spec) validateDestination(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Spec) validateKustomize(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Kustomize) { // not required
+		return nil
+	}
+
+	if m.Kustomize != nil {
+		if err := m.Kustomize.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("kustomize")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Spec) validateOverlays(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Overlays) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Overlays); i++ {
+		if swag.IsZero(m.Overlays[i]) { // not required
+			continue
+		}
+
+		if m.Overlays[i] != nil {
+			if err := m.Overlays[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("overlays" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Spec) validatePersistentVolumes(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.PersistentVolumes) { // not required
@@ -6,8 +6,11 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	strfmt "github.com/go-openapi/strfmt"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/swag"
 )
 
@@ -15,12 +18,55 @@ import (
 // swagger:model validationResponse
 type ValidationResponse struct {
 
-	// errors
+	// The error messages nested by field, as keyed in the application, and those about rendered files by filename under manifests and deploySpecs
 	Errors map[string]interface{} `json:"errors,omitempty"`
+
+	// The informational messages nested by field
+	Infos map[string]interface{} `json:"infos,omitempty"`
+
+	// The errors, warnings and informational messages as a flat list, ordered by path
+	Issues []*ValidationIssue `json:"issues"`
+
+	// The warning messages nested by field, or by filename under manifests and deploySpecs. Warnings do not prevent a release
+	Warnings map[string]interface{} `json:"warnings,omitempty"`
 }
 
 // Validate validates this validation response
 func (m *ValidationResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateIssues(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ValidationResponse) validateIssues(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Issues) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Issues); i++ {
+		if swag.IsZero(m.Issues[i]) { // not required
+			continue
+		}
+
+		if m.Issues[i] != nil {
+			if err := m.Issues[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("issues" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
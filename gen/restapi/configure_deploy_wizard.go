@@ -34,6 +34,8 @@ func configureAPI(api *operations.DeployWizardAPI) http.Handler {
 
 	api.JSONConsumer = runtime.JSONConsumer()
 
+	api.BinProducer = runtime.ByteStreamProducer()
+
 	api.JSONProducer = runtime.JSONProducer()
 
 	api.TxtProducer = runtime.TextProducer()
@@ -31,6 +31,97 @@ func init() {
     "version": "0.0.1"
   },
   "paths": {
+    "/app/bundle": {
+      "post": {
+        "description": "Downloads the files a release of the Kruise application would commit as an archive",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "apps"
+        ],
+        "operationId": "bundleApp",
+        "parameters": [
+          {
+            "enum": [
+              "tar.gz",
+              "zip"
+            ],
+            "type": "string",
+            "default": "tar.gz",
+            "description": "The archive format of the bundle",
+            "name": "archive",
+            "in": "query"
+          },
+          {
+            "description": "The application to bundle",
+            "name": "application",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/application"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the bundle",
+            "schema": {
+              "type": "file"
+            },
+            "headers": {
+              "Content-Disposition": {
+                "type": "string",
+                "description": "The file name of the bundle"
+              }
+            }
+          },
+          "400": {
+            "description": "invalid",
+            "schema": {
+              "$ref": "#/definitions/validationResponse"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
+    },
+    "/app/import": {
+      "post": {
+        "description": "Imports the Deployments, Services, Ingresses, ConfigMaps and PVCs of existing manifests into a Kruise application",
+        "tags": [
+          "apps"
+        ],
+        "operationId": "importApp",
+        "parameters": [
+          {
+            "description": "The manifests to import",
+            "name": "source",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/importRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "imported",
+            "schema": {
+              "$ref": "#/definitions/importResponse"
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "default": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
+    },
     "/app/preview": {
       "post": {
         "description": "Previews a new Kruise application",
@@ -57,6 +148,12 @@ func init() {
             "description": "created",
             "schema": {
               "type": "string"
+            },
+            "headers": {
+              "Warning": {
+                "type": "string",
+                "description": "The warnings of the application as comma-separated RFC 7234 warn-values with code 299"
+              }
             }
           },
           "400": {
@@ -68,6 +165,80 @@ func init() {
         }
       }
     },
+    "/app/preview/diff": {
+      "post": {
+        "description": "Previews what a release of the Kruise application would change in the GitOps repository",
+        "tags": [
+          "apps"
+        ],
+        "operationId": "previewAppDiff",
+        "parameters": [
+          {
+            "description": "The application to preview",
+            "name": "application",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/application"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "created",
+            "schema": {
+              "$ref": "#/definitions/previewDiffResponse"
+            }
+          },
+          "400": {
+            "description": "invalid",
+            "schema": {
+              "$ref": "#/definitions/validationResponse"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
+    },
+    "/app/preview/files": {
+      "post": {
+        "description": "Previews the files of a new Kruise application with the warnings found in each file",
+        "tags": [
+          "apps"
+        ],
+        "operationId": "previewAppFiles",
+        "parameters": [
+          {
+            "description": "The application to preview",
+            "name": "application",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/application"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "created",
+            "schema": {
+              "$ref": "#/definitions/previewResponse"
+            }
+          },
+          "400": {
+            "description": "invalid",
+            "schema": {
+              "$ref": "#/definitions/validationResponse"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
+    },
     "/app/release": {
       "post": {
         "description": "Generates a new Kruise application",
@@ -105,6 +276,57 @@ func init() {
         }
       }
     },
+    "/app/spec": {
+      "get": {
+        "description": "Loads the Kruise application a release committed next to its manifests",
+        "tags": [
+          "apps"
+        ],
+        "operationId": "loadApp",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uri",
+            "description": "The URL of the GitOps repository of the application",
+            "name": "url",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The destination path of the application in the repository",
+            "name": "path",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The branch the application is released to",
+            "name": "targetRevision",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the application",
+            "schema": {
+              "$ref": "#/definitions/application"
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "404": {
+            "description": "no application is committed at the path",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
+    },
     "/app/validation": {
       "post": {
         "description": "Validates the Kruise application",
@@ -161,6 +383,37 @@ func init() {
           }
         }
       }
+    },
+    "/templates": {
+      "get": {
+        "description": "List the templates applications are rendered with and the layer each comes from",
+        "tags": [
+          "general"
+        ],
+        "operationId": "listTemplates",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The team whose override templates are included",
+            "name": "team",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The effective templates",
+            "schema": {
+              "$ref": "#/definitions/templateListResponse"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
     }
   },
   "definitions": {
@@ -179,6 +432,28 @@ func init() {
         }
       }
     },
+    "backoff": {
+      "description": "The backoff strategy between sync retries",
+      "type": "object",
+      "properties": {
+        "duration": {
+          "description": "The amount of time to back off, e.g. 5s",
+          "type": "string",
+          "x-nullable": false
+        },
+        "factor": {
+          "description": "The factor to multiply the duration by after each failed retry. Argo CD uses a factor of 2 when it is 0",
+          "type": "integer",
+          "minimum": 0,
+          "x-nullable": false
+        },
+        "maxDuration": {
+          "description": "The maximum amount of time allowed for the backoff strategy, e.g. 3m",
+          "type": "string",
+          "x-nullable": false
+        }
+      }
+    },
     "component": {
       "type": "object",
       "required": [
@@ -198,11 +473,50 @@ func init() {
             "$ref": "#/definitions/ingress"
           }
         },
+        "replicas": {
+          "description": "The number of pods to run. Default is 1",
+          "type": "integer",
+          "default": 1,
+          "minimum": 1,
+          "x-nullable": false
+        },
         "service": {
           "$ref": "#/definitions/service"
         }
       }
     },
+    "componentOverlay": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "containers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/containerOverlay"
+          }
+        },
+        "ingresses": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ingressOverlay"
+          }
+        },
+        "name": {
+          "description": "The name of the service of the component to adjust",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "replicas": {
+          "description": "The number of pods to run in this environment",
+          "type": "integer",
+          "minimum": 1,
+          "x-nullable": false
+        }
+      }
+    },
     "configMap": {
       "type": "object",
       "required": [
@@ -216,7 +530,52 @@ func init() {
           "x-nullable": false
         },
         "name": {
-          "description": "The name of the ConfigMap",
+          "description": "The name of the ConfigMap, which also names its volume. A DNS label (RFC 1123)",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        }
+      }
+    },
+    "configMapGenerator": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "behavior": {
+          "description": "How to handle a ConfigMap of the same name in the base",
+          "type": "string",
+          "enum": [
+            "create",
+            "replace",
+            "merge"
+          ],
+          "x-nullable": false
+        },
+        "envs": {
+          "description": "Paths of env files relative to the kustomization. Refused, since a release does not write them to the repository",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "files": {
+          "description": "Paths of files relative to the kustomization. Refused, since a release does not write them to the repository",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "literals": {
+          "description": "key=value pairs",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "The name of the ConfigMap, without the hash suffix",
           "type": "string",
           "minLength": 1,
           "x-nullable": false
@@ -261,8 +620,11 @@ func init() {
           "minLength": 1,
           "x-nullable": false
         },
+        "livenessProbe": {
+          "$ref": "#/definitions/probe"
+        },
         "name": {
-          "description": "The name of this container within the service",
+          "description": "The name of this container within the service. A DNS label (RFC 1123)",
           "type": "string",
           "minLength": 1,
           "x-nullable": false
@@ -273,6 +635,12 @@ func init() {
             "type": "string"
           }
         },
+        "readinessProbe": {
+          "$ref": "#/definitions/probe"
+        },
+        "resources": {
+          "$ref": "#/definitions/resourceRequirements"
+        },
         "volumes": {
           "type": "array",
           "items": {
@@ -281,12 +649,67 @@ func init() {
         }
       }
     },
+    "containerOverlay": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "imageTag": {
+          "description": "The docker image tag to run in this environment",
+          "type": "string",
+          "x-nullable": false
+        },
+        "name": {
+          "description": "The name of the container to adjust",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "resources": {
+          "$ref": "#/definitions/resourceRequirements"
+        }
+      }
+    },
     "destination": {
       "type": "object",
       "required": [
         "url"
       ],
       "properties": {
+        "deployTarget": {
+          "description": "The continuous delivery tool that deploys the application. Defaults to the deploy target of the env, or argocd.",
+          "type": "string",
+          "enum": [
+            "argocd",
+            "flux"
+          ],
+          "x-nullable": false
+        },
+        "finalizers": {
+          "description": "Finalizers set on the ArgoCD Application, e.g. resources-finalizer.argocd.argoproj.io to cascade deletes",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        },
+        "format": {
+          "description": "The format the manifests are rendered in, a Kustomize base with overlays or a Helm chart. Defaults to kustomize.",
+          "type": "string",
+          "enum": [
+            "kustomize",
+            "helm"
+          ],
+          "x-nullable": false
+        },
+        "ignoreDifferences": {
+          "description": "Resource fields ArgoCD ignores when comparing live and desired state",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ignoreDifference"
+          }
+        },
         "path": {
           "description": "The relative path to the manifests in the git repo",
           "type": "string",
@@ -295,6 +718,15 @@ func init() {
           "minLength": 1,
           "x-nullable": false
         },
+        "pruneFiles": {
+          "description": "Whether a release removes the files the previous release committed under the path and this one no longer renders. Other files under the path are never removed.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": false
+        },
+        "syncPolicy": {
+          "$ref": "#/definitions/syncPolicy"
+        },
         "targetRevision": {
           "description": "Defines the commit, tag, or branch in which to sync the application to.",
           "type": "string",
@@ -328,6 +760,37 @@ func init() {
         }
       }
     },
+    "fileDiff": {
+      "type": "object",
+      "required": [
+        "name",
+        "status"
+      ],
+      "properties": {
+        "diff": {
+          "description": "The unified diff of the committed and the rendered file",
+          "type": "string",
+          "x-nullable": false
+        },
+        "name": {
+          "description": "The path of the file",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "status": {
+          "description": "How a release would change the file. A stale file the previous release committed is no longer rendered, but kept unless the destination prunes files.",
+          "type": "string",
+          "enum": [
+            "added",
+            "modified",
+            "deleted",
+            "stale"
+          ],
+          "x-nullable": false
+        }
+      }
+    },
     "healthStatus": {
       "type": "object",
       "properties": {
@@ -336,6 +799,93 @@ func init() {
         }
       }
     },
+    "ignoreDifference": {
+      "type": "object",
+      "required": [
+        "kind",
+        "jsonPointers"
+      ],
+      "properties": {
+        "group": {
+          "description": "The API group of the resource, empty for the core group",
+          "type": "string",
+          "x-nullable": false
+        },
+        "jsonPointers": {
+          "description": "JSON pointers to the ignored fields",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        },
+        "kind": {
+          "description": "The kind of the resource",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "name": {
+          "description": "The name of the resource. All resources of the kind when empty",
+          "type": "string",
+          "x-nullable": false
+        }
+      }
+    },
+    "importRepository": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "path": {
+          "description": "The path of the kustomize directory in the repository",
+          "type": "string",
+          "x-nullable": false
+        },
+        "targetRevision": {
+          "description": "The branch, tag or commit to import from. HEAD imports from the default branch",
+          "type": "string",
+          "x-nullable": false
+        },
+        "url": {
+          "description": "The URL of the git repository",
+          "type": "string",
+          "format": "uri",
+          "minLength": 1,
+          "x-nullable": false
+        }
+      }
+    },
+    "importRequest": {
+      "description": "Either the manifests or the repository to import them from",
+      "type": "object",
+      "properties": {
+        "manifests": {
+          "description": "Multi-document YAML with the manifests to import",
+          "type": "string",
+          "x-nullable": false
+        },
+        "repository": {
+          "$ref": "#/definitions/importRepository"
+        }
+      }
+    },
+    "importResponse": {
+      "type": "object",
+      "properties": {
+        "application": {
+          "$ref": "#/definitions/application"
+        },
+        "unmapped": {
+          "description": "The fields of the manifests the application has no counterpart for, sorted by resource and field",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/unmappedField"
+          }
+        }
+      }
+    },
     "ingress": {
       "type": "object",
       "required": [
@@ -357,6 +907,27 @@ func init() {
         }
       }
     },
+    "ingressOverlay": {
+      "type": "object",
+      "required": [
+        "host",
+        "newHost"
+      ],
+      "properties": {
+        "host": {
+          "description": "The hostname of the base ingress to adjust",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "newHost": {
+          "description": "The hostname of the ingress in this environment",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        }
+      }
+    },
     "ingressPath": {
       "type": "object",
       "required": [
@@ -365,7 +936,7 @@ func init() {
       ],
       "properties": {
         "path": {
-          "description": "Path is matched against the path of an incoming request",
+          "description": "Path is matched against the path of an incoming request. Starts with /",
           "type": "string",
           "default": "/",
           "minLength": 1,
@@ -379,6 +950,91 @@ func init() {
         }
       }
     },
+    "kustomize": {
+      "description": "Kustomize features applied to the base manifests",
+      "type": "object",
+      "properties": {
+        "commonAnnotations": {
+          "description": "Annotations added to all resources",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "commonLabels": {
+          "description": "Labels added to all resources and selectors",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "configMapGenerator": {
+          "description": "ConfigMaps generated with a hash suffix, so pods mounting them roll when they change",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configMapGenerator"
+          }
+        },
+        "images": {
+          "description": "Overrides of the images of all containers",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/kustomizeImage"
+          }
+        },
+        "namePrefix": {
+          "description": "Prepended to the names of all resources",
+          "type": "string",
+          "x-nullable": false
+        },
+        "nameSuffix": {
+          "description": "Appended to the names of all resources",
+          "type": "string",
+          "x-nullable": false
+        },
+        "namespace": {
+          "description": "The namespace of all resources. Defaults to the namespace of the application",
+          "type": "string",
+          "x-nullable": false
+        },
+        "secretGenerator": {
+          "description": "Secrets generated with a hash suffix. Refused, since a release would commit them in plain text; use a SealedSecret or an ExternalSecret",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/secretGenerator"
+          }
+        }
+      }
+    },
+    "kustomizeImage": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "digest": {
+          "description": "The digest to replace its tag with",
+          "type": "string",
+          "x-nullable": false
+        },
+        "name": {
+          "description": "The image to override",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "newName": {
+          "description": "The image to replace it with",
+          "type": "string",
+          "x-nullable": false
+        },
+        "newTag": {
+          "description": "The tag to replace its tag with",
+          "type": "string",
+          "x-nullable": false
+        }
+      }
+    },
     "labels": {
       "type": "object",
       "required": [
@@ -439,15 +1095,41 @@ func init() {
           "$ref": "#/definitions/labels"
         },
         "name": {
-          "description": "The name of the application",
+          "description": "The name of the application. A DNS label (RFC 1123)",
           "type": "string",
           "minLength": 1,
           "x-nullable": false
         },
         "namespace": {
-          "description": "The namespace to deploy to",
+          "description": "The namespace to deploy to. A DNS label (RFC 1123)",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        }
+      }
+    },
+    "overlay": {
+      "description": "The adjustments of the base manifests for an environment",
+      "type": "object",
+      "required": [
+        "env"
+      ],
+      "properties": {
+        "components": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/componentOverlay"
+          }
+        },
+        "env": {
+          "description": "The environment the overlay applies to",
           "type": "string",
           "minLength": 1,
+          "enum": [
+            "Dev",
+            "Stage",
+            "Prod"
+          ],
           "x-nullable": false
         }
       }
@@ -480,7 +1162,7 @@ func init() {
           "x-nullable": false
         },
         "name": {
-          "description": "The name of the volume",
+          "description": "The name of the volume, which also names its claim. A DNS label (RFC 1123)",
           "type": "string",
           "minLength": 1,
           "x-nullable": false
@@ -494,58 +1176,249 @@ func init() {
         }
       }
     },
-    "service": {
+    "previewDiffResponse": {
       "type": "object",
-      "required": [
-        "name",
-        "type",
-        "ports"
-      ],
       "properties": {
-        "name": {
-          "description": "The name of the service",
-          "type": "string",
-          "minLength": 1,
-          "x-nullable": false
-        },
-        "ports": {
+        "files": {
+          "description": "The files a release would change, relative to the repository root",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/servicePort"
+            "$ref": "#/definitions/fileDiff"
           }
-        },
-        "type": {
-          "description": "The service type",
-          "type": "string",
-          "enum": [
-            "ClusterIP",
-            "ExternalName",
-            "LoadBalancer"
-          ],
-          "x-nullable": false
         }
       }
     },
-    "servicePort": {
+    "previewFile": {
       "type": "object",
       "required": [
-        "name",
-        "port"
+        "name"
       ],
       "properties": {
-        "name": {
-          "description": "The name of this port within the service",
+        "content": {
+          "description": "The content of the file",
           "type": "string",
-          "minLength": 1,
           "x-nullable": false
         },
-        "port": {
-          "description": "The port that will be exposed by this service",
-          "type": "integer",
+        "errors": {
+          "description": "Violations of the Kubernetes schemas found in the file, or for a values file in the resources the chart renders with it, which prevent a release",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "kind": {
+          "description": "The kind of the resources in the file",
+          "type": "string",
           "x-nullable": false
         },
-        "protocol": {
-          "description": "The IP protocol for this port. Supports \"TCP\" and \"UDP\". Default is TCP",
+        "name": {
+          "description": "The path of the file",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "warnings": {
+          "description": "Issues found in the file that do not prevent a release",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "previewResponse": {
+      "type": "object",
+      "properties": {
+        "deploySpecs": {
+          "description": "The deploy specs a release commits, e.g. the ArgoCD Application",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/previewFile"
+          }
+        },
+        "files": {
+          "description": "The manifests, kustomizations or chart files a release commits under the destination path",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/previewFile"
+          }
+        }
+      }
+    },
+    "probe": {
+      "description": "A check the kubelet runs against a port of a container. An HTTP GET of the path when one is set, a TCP connection otherwise",
+      "type": "object",
+      "required": [
+        "portName"
+      ],
+      "properties": {
+        "initialDelaySeconds": {
+          "description": "The seconds to wait after the container starts before the first check",
+          "type": "integer",
+          "format": "int64"
+        },
+        "path": {
+          "description": "The path of the HTTP GET request",
+          "type": "string"
+        },
+        "periodSeconds": {
+          "description": "The seconds between checks",
+          "type": "integer",
+          "format": "int64"
+        },
+        "portName": {
+          "description": "The name of a port of the container",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        }
+      }
+    },
+    "resourceList": {
+      "description": "Amounts of compute resources as Kubernetes quantities",
+      "type": "object",
+      "properties": {
+        "cpu": {
+          "description": "CPU in cores or millicores, e.g. 500m",
+          "type": "string",
+          "x-nullable": false
+        },
+        "memory": {
+          "description": "Memory in bytes or with a suffix, e.g. 256Mi",
+          "type": "string",
+          "x-nullable": false
+        }
+      }
+    },
+    "resourceRequirements": {
+      "description": "The compute resources required by a container",
+      "type": "object",
+      "properties": {
+        "limits": {
+          "$ref": "#/definitions/resourceList"
+        },
+        "requests": {
+          "$ref": "#/definitions/resourceList"
+        }
+      }
+    },
+    "retryStrategy": {
+      "description": "Controls retries of failed syncs",
+      "type": "object",
+      "properties": {
+        "backoff": {
+          "$ref": "#/definitions/backoff"
+        },
+        "limit": {
+          "description": "The maximum number of attempts when retrying a failed sync",
+          "type": "integer",
+          "minimum": 0,
+          "x-nullable": false
+        }
+      }
+    },
+    "secretGenerator": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "behavior": {
+          "description": "How to handle a Secret of the same name in the base",
+          "type": "string",
+          "enum": [
+            "create",
+            "replace",
+            "merge"
+          ],
+          "x-nullable": false
+        },
+        "envs": {
+          "description": "Paths of env files relative to the kustomization. Refused, since a release does not write them to the repository",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "files": {
+          "description": "Paths of files relative to the kustomization. Refused, since a release does not write them to the repository",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "literals": {
+          "description": "key=value pairs",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "The name of the Secret, without the hash suffix",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "type": {
+          "description": "The type of the Secret. Defaults to Opaque",
+          "type": "string",
+          "x-nullable": false
+        }
+      }
+    },
+    "service": {
+      "type": "object",
+      "required": [
+        "name",
+        "type",
+        "ports"
+      ],
+      "properties": {
+        "name": {
+          "description": "The name of the service. A DNS label (RFC 1035)",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "ports": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/servicePort"
+          }
+        },
+        "type": {
+          "description": "The service type",
+          "type": "string",
+          "enum": [
+            "ClusterIP",
+            "ExternalName",
+            "LoadBalancer"
+          ],
+          "x-nullable": false
+        }
+      }
+    },
+    "servicePort": {
+      "type": "object",
+      "required": [
+        "name",
+        "port"
+      ],
+      "properties": {
+        "name": {
+          "description": "The name of this port within the service. An IANA_SVC_NAME",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "port": {
+          "description": "The port that will be exposed by this service",
+          "type": "integer",
+          "x-nullable": false
+        },
+        "protocol": {
+          "description": "The IP protocol for this port. Supports \"TCP\" and \"UDP\". Default is TCP",
           "type": "string",
           "default": "TCP",
           "minLength": 1,
@@ -584,6 +1457,16 @@ func init() {
         "destination": {
           "$ref": "#/definitions/destination"
         },
+        "kustomize": {
+          "$ref": "#/definitions/kustomize"
+        },
+        "overlays": {
+          "description": "Per-environment adjustments rendered as Kustomize overlays of the base manifests",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/overlay"
+          }
+        },
         "persistentVolumes": {
           "type": "array",
           "items": {
@@ -592,63 +1475,228 @@ func init() {
         }
       }
     },
-    "validationResponse": {
+    "syncPolicy": {
+      "description": "Controls when and how ArgoCD syncs the application. Defaults depend on the env label.",
       "type": "object",
       "properties": {
-        "errors": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "object"
+        "automated": {
+          "$ref": "#/definitions/syncPolicyAutomated"
+        },
+        "retry": {
+          "$ref": "#/definitions/retryStrategy"
+        },
+        "syncOptions": {
+          "description": "Sync options in Key=Value form, e.g. CreateNamespace=true",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "minLength": 1
           }
         }
       }
     },
-    "volumeMount": {
+    "syncPolicyAutomated": {
+      "description": "Automated sync is enabled when set",
       "type": "object",
       "properties": {
-        "mountPath": {
-          "description": "Path within the container at which the volume should be mounted",
+        "prune": {
+          "description": "Delete resources that are no longer defined in git",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": false
+        },
+        "selfHeal": {
+          "description": "Revert changes made to the live resources",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": false
+        }
+      }
+    },
+    "templateFile": {
+      "type": "object",
+      "required": [
+        "name",
+        "layer"
+      ],
+      "properties": {
+        "layer": {
+          "description": "The layer the template comes from. Override templates replace embedded ones, and team templates replace both.",
           "type": "string",
-          "minLength": 1,
+          "enum": [
+            "embedded",
+            "override",
+            "team"
+          ],
           "x-nullable": false
         },
         "name": {
-          "description": "The name of the volume to mount",
+          "description": "The path of the template relative to the template directory",
           "type": "string",
           "minLength": 1,
           "x-nullable": false
+        }
+      }
+    },
+    "templateListResponse": {
+      "type": "object",
+      "properties": {
+        "templates": {
+          "description": "The effective templates, sorted by name",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/templateFile"
+          }
+        }
+      }
+    },
+    "unmappedField": {
+      "type": "object",
+      "required": [
+        "resource"
+      ],
+      "properties": {
+        "field": {
+          "description": "The path of the field in the resource, e.g. spec.template.spec.containers[0].env. Empty when no field of the resource could be imported",
+          "type": "string",
+          "x-nullable": false
         },
-        "readOnly": {
-          "description": "Mounted read-only if true, read-write otherwise",
-          "type": "boolean",
-          "default": false,
+        "message": {
+          "description": "Why the field could not be imported",
+          "type": "string",
           "x-nullable": false
         },
-        "subPath": {
-          "description": "Path within the volume from which the container's volume should be mounted",
+        "resource": {
+          "description": "The kind and name of the resource, e.g. Deployment/app1",
           "type": "string",
-          "default": "",
-          "x-nullable": true
+          "x-nullable": false
+        }
+      }
+    },
+    "validationIssue": {
+      "type": "object",
+      "required": [
+        "path",
+        "code",
+        "severity",
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "description": "The stable identifier of the kind of issue, e.g. required or dns-label",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
         },
-        "type": {
-          "description": "The type of the volume mount (ConfigMap, PersistentVolume, or Secret)",
+        "message": {
+          "description": "The issue in English",
           "type": "string",
           "minLength": 1,
+          "x-nullable": false
+        },
+        "params": {
+          "description": "The values the message is built from, by name, e.g. the invalid value",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "path": {
+          "description": "The JSON pointer (RFC 6901) of the field in the application, or \"\" for the application itself",
+          "type": "string",
+          "x-nullable": false
+        },
+        "severity": {
+          "description": "Whether the issue is an error, which prevents a release, a warning or informational",
+          "type": "string",
           "enum": [
-            "ConfigMap",
-            "PersistentVolume",
-            "Secret"
+            "error",
+            "warning",
+            "info"
           ],
           "x-nullable": false
         }
       }
-    }
-  },
-  "responses": {
-    "BadRequest": {
-      "description": "Bad request",
-      "schema": {
-        "type": "string"
+    },
+    "validationResponse": {
+      "type": "object",
+      "properties": {
+        "errors": {
+          "description": "The error messages nested by field, as keyed in the application, and those about rendered files by filename under manifests and deploySpecs",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          }
+        },
+        "infos": {
+          "description": "The informational messages nested by field",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          }
+        },
+        "issues": {
+          "description": "The errors, warnings and informational messages as a flat list, ordered by path",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/validationIssue"
+          }
+        },
+        "warnings": {
+          "description": "The warning messages nested by field, or by filename under manifests and deploySpecs. Warnings do not prevent a release",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          }
+        }
+      }
+    },
+    "volumeMount": {
+      "type": "object",
+      "properties": {
+        "mountPath": {
+          "description": "Path within the container at which the volume should be mounted",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "name": {
+          "description": "The name of the volume to mount",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "readOnly": {
+          "description": "Mounted read-only if true, read-write otherwise",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": false
+        },
+        "subPath": {
+          "description": "Path within the volume from which the container's volume should be mounted",
+          "type": "string",
+          "default": "",
+          "x-nullable": true
+        },
+        "type": {
+          "description": "The type of the volume mount (ConfigMap, PersistentVolume, or Secret)",
+          "type": "string",
+          "minLength": 1,
+          "enum": [
+            "ConfigMap",
+            "PersistentVolume",
+            "Secret"
+          ],
+          "x-nullable": false
+        }
+      }
+    }
+  },
+  "responses": {
+    "BadRequest": {
+      "description": "Bad request",
+      "schema": {
+        "type": "string"
       }
     },
     "InternalServerError": {
@@ -673,6 +1721,106 @@ func init() {
     "version": "0.0.1"
   },
   "paths": {
+    "/app/bundle": {
+      "post": {
+        "description": "Downloads the files a release of the Kruise application would commit as an archive",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "apps"
+        ],
+        "operationId": "bundleApp",
+        "parameters": [
+          {
+            "enum": [
+              "tar.gz",
+              "zip"
+            ],
+            "type": "string",
+            "default": "tar.gz",
+            "description": "The archive format of the bundle",
+            "name": "archive",
+            "in": "query"
+          },
+          {
+            "description": "The application to bundle",
+            "name": "application",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/application"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the bundle",
+            "schema": {
+              "type": "file"
+            },
+            "headers": {
+              "Content-Disposition": {
+                "type": "string",
+                "description": "The file name of the bundle"
+              }
+            }
+          },
+          "400": {
+            "description": "invalid",
+            "schema": {
+              "$ref": "#/definitions/validationResponse"
+            }
+          },
+          "default": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/app/import": {
+      "post": {
+        "description": "Imports the Deployments, Services, Ingresses, ConfigMaps and PVCs of existing manifests into a Kruise application",
+        "tags": [
+          "apps"
+        ],
+        "operationId": "importApp",
+        "parameters": [
+          {
+            "description": "The manifests to import",
+            "name": "source",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/importRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "imported",
+            "schema": {
+              "$ref": "#/definitions/importResponse"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/app/preview": {
       "post": {
         "description": "Previews a new Kruise application",
@@ -699,6 +1847,12 @@ func init() {
             "description": "created",
             "schema": {
               "type": "string"
+            },
+            "headers": {
+              "Warning": {
+                "type": "string",
+                "description": "The warnings of the application as comma-separated RFC 7234 warn-values with code 299"
+              }
             }
           },
           "400": {
@@ -716,6 +1870,86 @@ func init() {
         }
       }
     },
+    "/app/preview/diff": {
+      "post": {
+        "description": "Previews what a release of the Kruise application would change in the GitOps repository",
+        "tags": [
+          "apps"
+        ],
+        "operationId": "previewAppDiff",
+        "parameters": [
+          {
+            "description": "The application to preview",
+            "name": "application",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/application"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "created",
+            "schema": {
+              "$ref": "#/definitions/previewDiffResponse"
+            }
+          },
+          "400": {
+            "description": "invalid",
+            "schema": {
+              "$ref": "#/definitions/validationResponse"
+            }
+          },
+          "default": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/app/preview/files": {
+      "post": {
+        "description": "Previews the files of a new Kruise application with the warnings found in each file",
+        "tags": [
+          "apps"
+        ],
+        "operationId": "previewAppFiles",
+        "parameters": [
+          {
+            "description": "The application to preview",
+            "name": "application",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/application"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "created",
+            "schema": {
+              "$ref": "#/definitions/previewResponse"
+            }
+          },
+          "400": {
+            "description": "invalid",
+            "schema": {
+              "$ref": "#/definitions/validationResponse"
+            }
+          },
+          "default": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/app/release": {
       "post": {
         "description": "Generates a new Kruise application",
@@ -756,6 +1990,63 @@ func init() {
         }
       }
     },
+    "/app/spec": {
+      "get": {
+        "description": "Loads the Kruise application a release committed next to its manifests",
+        "tags": [
+          "apps"
+        ],
+        "operationId": "loadApp",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uri",
+            "description": "The URL of the GitOps repository of the application",
+            "name": "url",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The destination path of the application in the repository",
+            "name": "path",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The branch the application is released to",
+            "name": "targetRevision",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the application",
+            "schema": {
+              "$ref": "#/definitions/application"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "404": {
+            "description": "no application is committed at the path",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/app/validation": {
       "post": {
         "description": "Validates the Kruise application",
@@ -796,357 +2087,1008 @@ func init() {
         }
       }
     },
-    "/health": {
-      "get": {
-        "description": "Get the current health of the API",
-        "tags": [
-          "general"
-        ],
-        "operationId": "getHealth",
-        "responses": {
-          "200": {
-            "description": "Get current health response",
-            "schema": {
-              "$ref": "#/definitions/healthStatus"
-            }
-          },
-          "default": {
-            "description": "Error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    }
-  },
-  "definitions": {
-    "application": {
+    "/health": {
+      "get": {
+        "description": "Get the current health of the API",
+        "tags": [
+          "general"
+        ],
+        "operationId": "getHealth",
+        "responses": {
+          "200": {
+            "description": "Get current health response",
+            "schema": {
+              "$ref": "#/definitions/healthStatus"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/templates": {
+      "get": {
+        "description": "List the templates applications are rendered with and the layer each comes from",
+        "tags": [
+          "general"
+        ],
+        "operationId": "listTemplates",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The team whose override templates are included",
+            "name": "team",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The effective templates",
+            "schema": {
+              "$ref": "#/definitions/templateListResponse"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "application": {
+      "type": "object",
+      "required": [
+        "metadata",
+        "spec"
+      ],
+      "properties": {
+        "metadata": {
+          "$ref": "#/definitions/metadata"
+        },
+        "spec": {
+          "$ref": "#/definitions/spec"
+        }
+      }
+    },
+    "backoff": {
+      "description": "The backoff strategy between sync retries",
+      "type": "object",
+      "properties": {
+        "duration": {
+          "description": "The amount of time to back off, e.g. 5s",
+          "type": "string",
+          "x-nullable": false
+        },
+        "factor": {
+          "description": "The factor to multiply the duration by after each failed retry. Argo CD uses a factor of 2 when it is 0",
+          "type": "integer",
+          "minimum": 0,
+          "x-nullable": false
+        },
+        "maxDuration": {
+          "description": "The maximum amount of time allowed for the backoff strategy, e.g. 3m",
+          "type": "string",
+          "x-nullable": false
+        }
+      }
+    },
+    "component": {
+      "type": "object",
+      "required": [
+        "service",
+        "containers"
+      ],
+      "properties": {
+        "containers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/container"
+          }
+        },
+        "ingresses": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ingress"
+          }
+        },
+        "replicas": {
+          "description": "The number of pods to run. Default is 1",
+          "type": "integer",
+          "default": 1,
+          "minimum": 1,
+          "x-nullable": false
+        },
+        "service": {
+          "$ref": "#/definitions/service"
+        }
+      }
+    },
+    "componentOverlay": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "containers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/containerOverlay"
+          }
+        },
+        "ingresses": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ingressOverlay"
+          }
+        },
+        "name": {
+          "description": "The name of the service of the component to adjust",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "replicas": {
+          "description": "The number of pods to run in this environment",
+          "type": "integer",
+          "minimum": 1,
+          "x-nullable": false
+        }
+      }
+    },
+    "configMap": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "data": {
+          "description": "The content of the ConfigMap data key",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "name": {
+          "description": "The name of the ConfigMap, which also names its volume. A DNS label (RFC 1123)",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        }
+      }
+    },
+    "configMapGenerator": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "behavior": {
+          "description": "How to handle a ConfigMap of the same name in the base",
+          "type": "string",
+          "enum": [
+            "create",
+            "replace",
+            "merge"
+          ],
+          "x-nullable": false
+        },
+        "envs": {
+          "description": "Paths of env files relative to the kustomization. Refused, since a release does not write them to the repository",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "files": {
+          "description": "Paths of files relative to the kustomization. Refused, since a release does not write them to the repository",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "literals": {
+          "description": "key=value pairs",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "The name of the ConfigMap, without the hash suffix",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        }
+      }
+    },
+    "container": {
+      "type": "object",
+      "required": [
+        "name",
+        "image",
+        "imageTag",
+        "imagePullPolicy",
+        "portNames"
+      ],
+      "properties": {
+        "command": {
+          "description": "The command to run for the docker image's entrypoint.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "image": {
+          "description": "The docker image name for the container",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "imagePullPolicy": {
+          "description": "Image pull policy. One of Always or IfNotPresent.",
+          "type": "string",
+          "default": "IfNotPresent",
+          "minLength": 1,
+          "enum": [
+            "Always",
+            "IfNotPresent"
+          ],
+          "x-nullable": false
+        },
+        "imageTag": {
+          "description": "The docker image tag for the container",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "livenessProbe": {
+          "$ref": "#/definitions/probe"
+        },
+        "name": {
+          "description": "The name of this container within the service. A DNS label (RFC 1123)",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "portNames": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "readinessProbe": {
+          "$ref": "#/definitions/probe"
+        },
+        "resources": {
+          "$ref": "#/definitions/resourceRequirements"
+        },
+        "volumes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/volumeMount"
+          }
+        }
+      }
+    },
+    "containerOverlay": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "imageTag": {
+          "description": "The docker image tag to run in this environment",
+          "type": "string",
+          "x-nullable": false
+        },
+        "name": {
+          "description": "The name of the container to adjust",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "resources": {
+          "$ref": "#/definitions/resourceRequirements"
+        }
+      }
+    },
+    "destination": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "deployTarget": {
+          "description": "The continuous delivery tool that deploys the application. Defaults to the deploy target of the env, or argocd.",
+          "type": "string",
+          "enum": [
+            "argocd",
+            "flux"
+          ],
+          "x-nullable": false
+        },
+        "finalizers": {
+          "description": "Finalizers set on the ArgoCD Application, e.g. resources-finalizer.argocd.argoproj.io to cascade deletes",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        },
+        "format": {
+          "description": "The format the manifests are rendered in, a Kustomize base with overlays or a Helm chart. Defaults to kustomize.",
+          "type": "string",
+          "enum": [
+            "kustomize",
+            "helm"
+          ],
+          "x-nullable": false
+        },
+        "ignoreDifferences": {
+          "description": "Resource fields ArgoCD ignores when comparing live and desired state",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ignoreDifference"
+          }
+        },
+        "path": {
+          "description": "The relative path to the manifests in the git repo",
+          "type": "string",
+          "format": "filepath",
+          "default": "/",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "pruneFiles": {
+          "description": "Whether a release removes the files the previous release committed under the path and this one no longer renders. Other files under the path are never removed.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": false
+        },
+        "syncPolicy": {
+          "$ref": "#/definitions/syncPolicy"
+        },
+        "targetRevision": {
+          "description": "Defines the commit, tag, or branch in which to sync the application to.",
+          "type": "string",
+          "default": "HEAD",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "url": {
+          "description": "The git repo URL",
+          "type": "string",
+          "format": "uri",
+          "minLength": 1,
+          "x-nullable": false
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "message": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        }
+      }
+    },
+    "fileDiff": {
+      "type": "object",
+      "required": [
+        "name",
+        "status"
+      ],
+      "properties": {
+        "diff": {
+          "description": "The unified diff of the committed and the rendered file",
+          "type": "string",
+          "x-nullable": false
+        },
+        "name": {
+          "description": "The path of the file",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "status": {
+          "description": "How a release would change the file. A stale file the previous release committed is no longer rendered, but kept unless the destination prunes files.",
+          "type": "string",
+          "enum": [
+            "added",
+            "modified",
+            "deleted",
+            "stale"
+          ],
+          "x-nullable": false
+        }
+      }
+    },
+    "healthStatus": {
+      "type": "object",
+      "properties": {
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "ignoreDifference": {
+      "type": "object",
+      "required": [
+        "kind",
+        "jsonPointers"
+      ],
+      "properties": {
+        "group": {
+          "description": "The API group of the resource, empty for the core group",
+          "type": "string",
+          "x-nullable": false
+        },
+        "jsonPointers": {
+          "description": "JSON pointers to the ignored fields",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        },
+        "kind": {
+          "description": "The kind of the resource",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "name": {
+          "description": "The name of the resource. All resources of the kind when empty",
+          "type": "string",
+          "x-nullable": false
+        }
+      }
+    },
+    "importRepository": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "path": {
+          "description": "The path of the kustomize directory in the repository",
+          "type": "string",
+          "x-nullable": false
+        },
+        "targetRevision": {
+          "description": "The branch, tag or commit to import from. HEAD imports from the default branch",
+          "type": "string",
+          "x-nullable": false
+        },
+        "url": {
+          "description": "The URL of the git repository",
+          "type": "string",
+          "format": "uri",
+          "minLength": 1,
+          "x-nullable": false
+        }
+      }
+    },
+    "importRequest": {
+      "description": "Either the manifests or the repository to import them from",
+      "type": "object",
+      "properties": {
+        "manifests": {
+          "description": "Multi-document YAML with the manifests to import",
+          "type": "string",
+          "x-nullable": false
+        },
+        "repository": {
+          "$ref": "#/definitions/importRepository"
+        }
+      }
+    },
+    "importResponse": {
+      "type": "object",
+      "properties": {
+        "application": {
+          "$ref": "#/definitions/application"
+        },
+        "unmapped": {
+          "description": "The fields of the manifests the application has no counterpart for, sorted by resource and field",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/unmappedField"
+          }
+        }
+      }
+    },
+    "ingress": {
+      "type": "object",
+      "required": [
+        "host",
+        "paths"
+      ],
+      "properties": {
+        "host": {
+          "description": "The hostname for the ingress",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "paths": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ingressPath"
+          }
+        }
+      }
+    },
+    "ingressOverlay": {
       "type": "object",
       "required": [
-        "metadata",
-        "spec"
+        "host",
+        "newHost"
       ],
       "properties": {
-        "metadata": {
-          "$ref": "#/definitions/metadata"
+        "host": {
+          "description": "The hostname of the base ingress to adjust",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
         },
-        "spec": {
-          "$ref": "#/definitions/spec"
+        "newHost": {
+          "description": "The hostname of the ingress in this environment",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
         }
       }
     },
-    "component": {
+    "ingressPath": {
       "type": "object",
       "required": [
-        "service",
-        "containers"
+        "path",
+        "portName"
       ],
       "properties": {
-        "containers": {
+        "path": {
+          "description": "Path is matched against the path of an incoming request. Starts with /",
+          "type": "string",
+          "default": "/",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "portName": {
+          "description": "Specifies the port name of the service to expose",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        }
+      }
+    },
+    "kustomize": {
+      "description": "Kustomize features applied to the base manifests",
+      "type": "object",
+      "properties": {
+        "commonAnnotations": {
+          "description": "Annotations added to all resources",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "commonLabels": {
+          "description": "Labels added to all resources and selectors",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "configMapGenerator": {
+          "description": "ConfigMaps generated with a hash suffix, so pods mounting them roll when they change",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/container"
+            "$ref": "#/definitions/configMapGenerator"
           }
         },
-        "ingresses": {
+        "images": {
+          "description": "Overrides of the images of all containers",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/ingress"
+            "$ref": "#/definitions/kustomizeImage"
           }
         },
-        "service": {
-          "$ref": "#/definitions/service"
+        "namePrefix": {
+          "description": "Prepended to the names of all resources",
+          "type": "string",
+          "x-nullable": false
+        },
+        "nameSuffix": {
+          "description": "Appended to the names of all resources",
+          "type": "string",
+          "x-nullable": false
+        },
+        "namespace": {
+          "description": "The namespace of all resources. Defaults to the namespace of the application",
+          "type": "string",
+          "x-nullable": false
+        },
+        "secretGenerator": {
+          "description": "Secrets generated with a hash suffix. Refused, since a release would commit them in plain text; use a SealedSecret or an ExternalSecret",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/secretGenerator"
+          }
         }
       }
     },
-    "configMap": {
+    "kustomizeImage": {
       "type": "object",
       "required": [
         "name"
       ],
       "properties": {
-        "data": {
-          "description": "The content of the ConfigMap data key",
+        "digest": {
+          "description": "The digest to replace its tag with",
           "type": "string",
-          "minLength": 1,
           "x-nullable": false
         },
         "name": {
-          "description": "The name of the ConfigMap",
+          "description": "The image to override",
           "type": "string",
           "minLength": 1,
           "x-nullable": false
+        },
+        "newName": {
+          "description": "The image to replace it with",
+          "type": "string",
+          "x-nullable": false
+        },
+        "newTag": {
+          "description": "The tag to replace its tag with",
+          "type": "string",
+          "x-nullable": false
         }
       }
     },
-    "container": {
+    "labels": {
       "type": "object",
       "required": [
-        "name",
-        "image",
-        "imageTag",
-        "imagePullPolicy",
-        "portNames"
+        "version",
+        "team",
+        "env",
+        "region"
       ],
       "properties": {
-        "command": {
-          "description": "The command to run for the docker image's entrypoint.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "image": {
-          "description": "The docker image name for the container",
+        "env": {
+          "description": "The environment to deploy to",
           "type": "string",
+          "default": "Dev",
           "minLength": 1,
+          "enum": [
+            "Dev",
+            "Stage",
+            "Prod"
+          ],
           "x-nullable": false
         },
-        "imagePullPolicy": {
-          "description": "Image pull policy. One of Always or IfNotPresent.",
+        "region": {
+          "description": "The region to deploy to",
           "type": "string",
-          "default": "IfNotPresent",
+          "default": "STL",
           "minLength": 1,
           "enum": [
-            "Always",
-            "IfNotPresent"
+            "STL",
+            "KCI",
+            "BEL"
           ],
           "x-nullable": false
         },
-        "imageTag": {
-          "description": "The docker image tag for the container",
+        "team": {
+          "description": "The name of the team or tenant",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "version": {
+          "description": "The version or release name of the application",
           "type": "string",
           "minLength": 1,
           "x-nullable": false
+        }
+      }
+    },
+    "metadata": {
+      "type": "object",
+      "required": [
+        "name",
+        "namespace",
+        "labels"
+      ],
+      "properties": {
+        "labels": {
+          "description": "Arbitrary labels",
+          "$ref": "#/definitions/labels"
         },
         "name": {
-          "description": "The name of this container within the service",
+          "description": "The name of the application. A DNS label (RFC 1123)",
           "type": "string",
           "minLength": 1,
           "x-nullable": false
         },
-        "portNames": {
+        "namespace": {
+          "description": "The namespace to deploy to. A DNS label (RFC 1123)",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        }
+      }
+    },
+    "overlay": {
+      "description": "The adjustments of the base manifests for an environment",
+      "type": "object",
+      "required": [
+        "env"
+      ],
+      "properties": {
+        "components": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/componentOverlay"
           }
         },
-        "volumes": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/volumeMount"
-          }
+        "env": {
+          "description": "The environment the overlay applies to",
+          "type": "string",
+          "minLength": 1,
+          "enum": [
+            "Dev",
+            "Stage",
+            "Prod"
+          ],
+          "x-nullable": false
         }
       }
     },
-    "destination": {
+    "persistentVolume": {
       "type": "object",
       "required": [
-        "url"
+        "name",
+        "accessMode",
+        "capacity",
+        "storageClassName"
       ],
       "properties": {
-        "path": {
-          "description": "The relative path to the manifests in the git repo",
+        "accessMode": {
+          "description": "The desired access mode for the volume",
           "type": "string",
-          "format": "filepath",
-          "default": "/",
+          "default": "ReadWriteOnce",
           "minLength": 1,
+          "enum": [
+            "ReadWriteOnce",
+            "ReadOnlyMany",
+            "ReadWriteMany"
+          ],
           "x-nullable": false
         },
-        "targetRevision": {
-          "description": "Defines the commit, tag, or branch in which to sync the application to.",
+        "capacity": {
+          "description": "The desired size of the volume in GB",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": false
+        },
+        "name": {
+          "description": "The name of the volume, which also names its claim. A DNS label (RFC 1123)",
           "type": "string",
-          "default": "HEAD",
           "minLength": 1,
           "x-nullable": false
         },
-        "url": {
-          "description": "The git repo URL",
+        "storageClassName": {
+          "description": "The desired storage class for the volume",
           "type": "string",
-          "format": "uri",
+          "default": "default",
           "minLength": 1,
           "x-nullable": false
         }
       }
     },
-    "error": {
+    "previewDiffResponse": {
+      "type": "object",
+      "properties": {
+        "files": {
+          "description": "The files a release would change, relative to the repository root",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/fileDiff"
+          }
+        }
+      }
+    },
+    "previewFile": {
       "type": "object",
       "required": [
-        "message"
+        "name"
       ],
       "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int64"
+        "content": {
+          "description": "The content of the file",
+          "type": "string",
+          "x-nullable": false
+        },
+        "errors": {
+          "description": "Violations of the Kubernetes schemas found in the file, or for a values file in the resources the chart renders with it, which prevent a release",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "kind": {
+          "description": "The kind of the resources in the file",
+          "type": "string",
+          "x-nullable": false
         },
-        "message": {
+        "name": {
+          "description": "The path of the file",
           "type": "string",
           "minLength": 1,
           "x-nullable": false
+        },
+        "warnings": {
+          "description": "Issues found in the file that do not prevent a release",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "healthStatus": {
-      "type": "object",
-      "properties": {
-        "status": {
-          "type": "string"
-        }
-      }
-    },
-    "ingress": {
+    "previewResponse": {
       "type": "object",
-      "required": [
-        "host",
-        "paths"
-      ],
       "properties": {
-        "host": {
-          "description": "The hostname for the ingress",
-          "type": "string",
-          "minLength": 1,
-          "x-nullable": false
+        "deploySpecs": {
+          "description": "The deploy specs a release commits, e.g. the ArgoCD Application",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/previewFile"
+          }
         },
-        "paths": {
+        "files": {
+          "description": "The manifests, kustomizations or chart files a release commits under the destination path",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/ingressPath"
+            "$ref": "#/definitions/previewFile"
           }
         }
       }
     },
-    "ingressPath": {
+    "probe": {
+      "description": "A check the kubelet runs against a port of a container. An HTTP GET of the path when one is set, a TCP connection otherwise",
       "type": "object",
       "required": [
-        "path",
         "portName"
       ],
       "properties": {
+        "initialDelaySeconds": {
+          "description": "The seconds to wait after the container starts before the first check",
+          "type": "integer",
+          "format": "int64"
+        },
         "path": {
-          "description": "Path is matched against the path of an incoming request",
-          "type": "string",
-          "default": "/",
-          "minLength": 1,
-          "x-nullable": false
+          "description": "The path of the HTTP GET request",
+          "type": "string"
+        },
+        "periodSeconds": {
+          "description": "The seconds between checks",
+          "type": "integer",
+          "format": "int64"
         },
         "portName": {
-          "description": "Specifies the port name of the service to expose",
+          "description": "The name of a port of the container",
           "type": "string",
           "minLength": 1,
           "x-nullable": false
         }
       }
     },
-    "labels": {
+    "resourceList": {
+      "description": "Amounts of compute resources as Kubernetes quantities",
       "type": "object",
-      "required": [
-        "version",
-        "team",
-        "env",
-        "region"
-      ],
       "properties": {
-        "env": {
-          "description": "The environment to deploy to",
-          "type": "string",
-          "default": "Dev",
-          "minLength": 1,
-          "enum": [
-            "Dev",
-            "Stage",
-            "Prod"
-          ],
-          "x-nullable": false
-        },
-        "region": {
-          "description": "The region to deploy to",
-          "type": "string",
-          "default": "STL",
-          "minLength": 1,
-          "enum": [
-            "STL",
-            "KCI",
-            "BEL"
-          ],
-          "x-nullable": false
-        },
-        "team": {
-          "description": "The name of the team or tenant",
+        "cpu": {
+          "description": "CPU in cores or millicores, e.g. 500m",
           "type": "string",
-          "minLength": 1,
           "x-nullable": false
         },
-        "version": {
-          "description": "The version or release name of the application",
+        "memory": {
+          "description": "Memory in bytes or with a suffix, e.g. 256Mi",
           "type": "string",
-          "minLength": 1,
           "x-nullable": false
         }
       }
     },
-    "metadata": {
+    "resourceRequirements": {
+      "description": "The compute resources required by a container",
       "type": "object",
-      "required": [
-        "name",
-        "namespace",
-        "labels"
-      ],
       "properties": {
-        "labels": {
-          "description": "Arbitrary labels",
-          "$ref": "#/definitions/labels"
+        "limits": {
+          "$ref": "#/definitions/resourceList"
         },
-        "name": {
-          "description": "The name of the application",
-          "type": "string",
-          "minLength": 1,
-          "x-nullable": false
+        "requests": {
+          "$ref": "#/definitions/resourceList"
+        }
+      }
+    },
+    "retryStrategy": {
+      "description": "Controls retries of failed syncs",
+      "type": "object",
+      "properties": {
+        "backoff": {
+          "$ref": "#/definitions/backoff"
         },
-        "namespace": {
-          "description": "The namespace to deploy to",
-          "type": "string",
-          "minLength": 1,
+        "limit": {
+          "description": "The maximum number of attempts when retrying a failed sync",
+          "type": "integer",
+          "minimum": 0,
           "x-nullable": false
         }
       }
     },
-    "persistentVolume": {
+    "secretGenerator": {
       "type": "object",
       "required": [
-        "name",
-        "accessMode",
-        "capacity",
-        "storageClassName"
+        "name"
       ],
       "properties": {
-        "accessMode": {
-          "description": "The desired access mode for the volume",
+        "behavior": {
+          "description": "How to handle a Secret of the same name in the base",
           "type": "string",
-          "default": "ReadWriteOnce",
-          "minLength": 1,
           "enum": [
-            "ReadWriteOnce",
-            "ReadOnlyMany",
-            "ReadWriteMany"
+            "create",
+            "replace",
+            "merge"
           ],
           "x-nullable": false
         },
-        "capacity": {
-          "description": "The desired size of the volume in GB",
-          "type": "integer",
-          "format": "int64",
-          "x-nullable": false
+        "envs": {
+          "description": "Paths of env files relative to the kustomization. Refused, since a release does not write them to the repository",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "files": {
+          "description": "Paths of files relative to the kustomization. Refused, since a release does not write them to the repository",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "literals": {
+          "description": "key=value pairs",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
         "name": {
-          "description": "The name of the volume",
+          "description": "The name of the Secret, without the hash suffix",
           "type": "string",
           "minLength": 1,
           "x-nullable": false
         },
-        "storageClassName": {
-          "description": "The desired storage class for the volume",
+        "type": {
+          "description": "The type of the Secret. Defaults to Opaque",
           "type": "string",
-          "default": "default",
-          "minLength": 1,
           "x-nullable": false
         }
       }
@@ -1160,7 +3102,7 @@ func init() {
       ],
       "properties": {
         "name": {
-          "description": "The name of the service",
+          "description": "The name of the service. A DNS label (RFC 1035)",
           "type": "string",
           "minLength": 1,
           "x-nullable": false
@@ -1191,7 +3133,7 @@ func init() {
       ],
       "properties": {
         "name": {
-          "description": "The name of this port within the service",
+          "description": "The name of this port within the service. An IANA_SVC_NAME",
           "type": "string",
           "minLength": 1,
           "x-nullable": false
@@ -1241,6 +3183,16 @@ func init() {
         "destination": {
           "$ref": "#/definitions/destination"
         },
+        "kustomize": {
+          "$ref": "#/definitions/kustomize"
+        },
+        "overlays": {
+          "description": "Per-environment adjustments rendered as Kustomize overlays of the base manifests",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/overlay"
+          }
+        },
         "persistentVolumes": {
           "type": "array",
           "items": {
@@ -1249,10 +3201,175 @@ func init() {
         }
       }
     },
+    "syncPolicy": {
+      "description": "Controls when and how ArgoCD syncs the application. Defaults depend on the env label.",
+      "type": "object",
+      "properties": {
+        "automated": {
+          "$ref": "#/definitions/syncPolicyAutomated"
+        },
+        "retry": {
+          "$ref": "#/definitions/retryStrategy"
+        },
+        "syncOptions": {
+          "description": "Sync options in Key=Value form, e.g. CreateNamespace=true",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        }
+      }
+    },
+    "syncPolicyAutomated": {
+      "description": "Automated sync is enabled when set",
+      "type": "object",
+      "properties": {
+        "prune": {
+          "description": "Delete resources that are no longer defined in git",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": false
+        },
+        "selfHeal": {
+          "description": "Revert changes made to the live resources",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": false
+        }
+      }
+    },
+    "templateFile": {
+      "type": "object",
+      "required": [
+        "name",
+        "layer"
+      ],
+      "properties": {
+        "layer": {
+          "description": "The layer the template comes from. Override templates replace embedded ones, and team templates replace both.",
+          "type": "string",
+          "enum": [
+            "embedded",
+            "override",
+            "team"
+          ],
+          "x-nullable": false
+        },
+        "name": {
+          "description": "The path of the template relative to the template directory",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        }
+      }
+    },
+    "templateListResponse": {
+      "type": "object",
+      "properties": {
+        "templates": {
+          "description": "The effective templates, sorted by name",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/templateFile"
+          }
+        }
+      }
+    },
+    "unmappedField": {
+      "type": "object",
+      "required": [
+        "resource"
+      ],
+      "properties": {
+        "field": {
+          "description": "The path of the field in the resource, e.g. spec.template.spec.containers[0].env. Empty when no field of the resource could be imported",
+          "type": "string",
+          "x-nullable": false
+        },
+        "message": {
+          "description": "Why the field could not be imported",
+          "type": "string",
+          "x-nullable": false
+        },
+        "resource": {
+          "description": "The kind and name of the resource, e.g. Deployment/app1",
+          "type": "string",
+          "x-nullable": false
+        }
+      }
+    },
+    "validationIssue": {
+      "type": "object",
+      "required": [
+        "path",
+        "code",
+        "severity",
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "description": "The stable identifier of the kind of issue, e.g. required or dns-label",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "message": {
+          "description": "The issue in English",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "params": {
+          "description": "The values the message is built from, by name, e.g. the invalid value",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "path": {
+          "description": "The JSON pointer (RFC 6901) of the field in the application, or \"\" for the application itself",
+          "type": "string",
+          "x-nullable": false
+        },
+        "severity": {
+          "description": "Whether the issue is an error, which prevents a release, a warning or informational",
+          "type": "string",
+          "enum": [
+            "error",
+            "warning",
+            "info"
+          ],
+          "x-nullable": false
+        }
+      }
+    },
     "validationResponse": {
       "type": "object",
       "properties": {
         "errors": {
+          "description": "The error messages nested by field, as keyed in the application, and those about rendered files by filename under manifests and deploySpecs",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          }
+        },
+        "infos": {
+          "description": "The informational messages nested by field",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          }
+        },
+        "issues": {
+          "description": "The errors, warnings and informational messages as a flat list, ordered by path",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/validationIssue"
+          }
+        },
+        "warnings": {
+          "description": "The warning messages nested by field, or by filename under manifests and deploySpecs. Warnings do not prevent a release",
           "type": "object",
           "additionalProperties": {
             "type": "object"
@@ -21,6 +21,10 @@ const PreviewAppCreatedCode int = 201
 swagger:response previewAppCreated
 */
 type PreviewAppCreated struct {
+	/*The warnings of the application as comma-separated RFC 7234 warn-values with code 299
+
+	 */
+	Warning string `json:"Warning"`
 
 	/*
 	  In: Body
@@ -34,6 +38,17 @@ func NewPreviewAppCreated() *PreviewAppCreated {
 	return &PreviewAppCreated{}
 }
 
+// WithWarning adds the warning to the preview app created response
+func (o *PreviewAppCreated) WithWarning(warning string) *PreviewAppCreated {
+	o.Warning = warning
+	return o
+}
+
+// SetWarning sets the warning to the preview app created response
+func (o *PreviewAppCreated) SetWarning(warning string) {
+	o.Warning = warning
+}
+
 // WithPayload adds the payload to the preview app created response
 func (o *PreviewAppCreated) WithPayload(payload string) *PreviewAppCreated {
 	o.Payload = payload
@@ -48,6 +63,13 @@ func (o *PreviewAppCreated) SetPayload(payload string) {
 // WriteResponse to the client
 func (o *PreviewAppCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header Warning
+
+	warning := o.Warning
+	if warning != "" {
+		rw.Header().Set("Warning", warning)
+	}
+
 	rw.WriteHeader(201)
 	payload := o.Payload
 	if err := producer.Produce(rw, payload); err != nil {
@@ -40,14 +40,33 @@ func NewDeployWizardAPI(spec *loads.Document) *DeployWizardAPI {
 		APIKeyAuthenticator: security.APIKeyAuth,
 		BearerAuthenticator: security.BearerAuth,
 		JSONConsumer:        runtime.JSONConsumer(),
+		BinProducer:         runtime.ByteStreamProducer(),
 		JSONProducer:        runtime.JSONProducer(),
 		TxtProducer:         runtime.TextProducer(),
+		AppsBundleAppHandler: apps.BundleAppHandlerFunc(func(params apps.BundleAppParams) middleware.Responder {
+			return middleware.NotImplemented("operation AppsBundleApp has not yet been implemented")
+		}),
 		GeneralGetHealthHandler: general.GetHealthHandlerFunc(func(params general.GetHealthParams) middleware.Responder {
 			return middleware.NotImplemented("operation GeneralGetHealth has not yet been implemented")
 		}),
+		AppsImportAppHandler: apps.ImportAppHandlerFunc(func(params apps.ImportAppParams) middleware.Responder {
+			return middleware.NotImplemented("operation AppsImportApp has not yet been implemented")
+		}),
+		GeneralListTemplatesHandler: general.ListTemplatesHandlerFunc(func(params general.ListTemplatesParams) middleware.Responder {
+			return middleware.NotImplemented("operation GeneralListTemplates has not yet been implemented")
+		}),
+		AppsLoadAppHandler: apps.LoadAppHandlerFunc(func(params apps.LoadAppParams) middleware.Responder {
+			return middleware.NotImplemented("operation AppsLoadApp has not yet been implemented")
+		}),
 		AppsPreviewAppHandler: apps.PreviewAppHandlerFunc(func(params apps.PreviewAppParams) middleware.Responder {
 			return middleware.NotImplemented("operation AppsPreviewApp has not yet been implemented")
 		}),
+		AppsPreviewAppDiffHandler: apps.PreviewAppDiffHandlerFunc(func(params apps.PreviewAppDiffParams) middleware.Responder {
+			return middleware.NotImplemented("operation AppsPreviewAppDiff has not yet been implemented")
+		}),
+		AppsPreviewAppFilesHandler: apps.PreviewAppFilesHandlerFunc(func(params apps.PreviewAppFilesParams) middleware.Responder {
+			return middleware.NotImplemented("operation AppsPreviewAppFiles has not yet been implemented")
+		}),
 		AppsReleaseAppHandler: apps.ReleaseAppHandlerFunc(func(params apps.ReleaseAppParams) middleware.Responder {
 			return middleware.NotImplemented("operation AppsReleaseApp has not yet been implemented")
 		}),
@@ -82,15 +101,29 @@ type DeployWizardAPI struct {
 	// JSONConsumer registers a consumer for a "application/json" mime type
 	JSONConsumer runtime.Consumer
 
+	// BinProducer registers a producer for a "application/octet-stream" mime type
+	BinProducer runtime.Producer
 	// JSONProducer registers a producer for a "application/json" mime type
 	JSONProducer runtime.Producer
 	// TxtProducer registers a producer for a "text/plain" mime type
 	TxtProducer runtime.Producer
 
+	// AppsBundleAppHandler sets the operation handler for the bundle app operation
+	AppsBundleAppHandler apps.BundleAppHandler
 	// GeneralGetHealthHandler sets the operation handler for the get health operation
 	GeneralGetHealthHandler general.GetHealthHandler
+	// AppsImportAppHandler sets the operation handler for the import app operation
+	AppsImportAppHandler apps.ImportAppHandler
+	// GeneralListTemplatesHandler sets the operation handler for the list templates operation
+	GeneralListTemplatesHandler general.ListTemplatesHandler
+	// AppsLoadAppHandler sets the operation handler for the load app operation
+	AppsLoadAppHandler apps.LoadAppHandler
 	// AppsPreviewAppHandler sets the operation handler for the preview app operation
 	AppsPreviewAppHandler apps.PreviewAppHandler
+	// AppsPreviewAppDiffHandler sets the operation handler for the preview app diff operation
+	AppsPreviewAppDiffHandler apps.PreviewAppDiffHandler
+	// AppsPreviewAppFilesHandler sets the operation handler for the preview app files operation
+	AppsPreviewAppFilesHandler apps.PreviewAppFilesHandler
 	// AppsReleaseAppHandler sets the operation handler for the release app operation
 	AppsReleaseAppHandler apps.ReleaseAppHandler
 	// ValidationsValidateApplicationHandler sets the operation handler for the validate application operation
@@ -154,6 +187,10 @@ func (o *DeployWizardAPI) Validate() error {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
 
+	if o.BinProducer == nil {
+		unregistered = append(unregistered, "BinProducer")
+	}
+
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
@@ -162,14 +199,38 @@ func (o *DeployWizardAPI) Validate() error {
 		unregistered = append(unregistered, "TxtProducer")
 	}
 
+	if o.AppsBundleAppHandler == nil {
+		unregistered = append(unregistered, "apps.BundleAppHandler")
+	}
+
 	if o.GeneralGetHealthHandler == nil {
 		unregistered = append(unregistered, "general.GetHealthHandler")
 	}
 
+	if o.AppsImportAppHandler == nil {
+		unregistered = append(unregistered, "apps.ImportAppHandler")
+	}
+
+	if o.GeneralListTemplatesHandler == nil {
+		unregistered = append(unregistered, "general.ListTemplatesHandler")
+	}
+
+	if o.AppsLoadAppHandler == nil {
+		unregistered = append(unregistered, "apps.LoadAppHandler")
+	}
+
 	if o.AppsPreviewAppHandler == nil {
 		unregistered = append(unregistered, "apps.PreviewAppHandler")
 	}
 
+	if o.AppsPreviewAppDiffHandler == nil {
+		unregistered = append(unregistered, "apps.PreviewAppDiffHandler")
+	}
+
+	if o.AppsPreviewAppFilesHandler == nil {
+		unregistered = append(unregistered, "apps.PreviewAppFilesHandler")
+	}
+
 	if o.AppsReleaseAppHandler == nil {
 		unregistered = append(unregistered, "apps.ReleaseAppHandler")
 	}
@@ -231,6 +292,9 @@ func (o *DeployWizardAPI) ProducersFor(mediaTypes []string) map[string]runtime.P
 	for _, mt := range mediaTypes {
 		switch mt {
 
+		case "application/octet-stream":
+			result["application/octet-stream"] = o.BinProducer
+
 		case "application/json":
 			result["application/json"] = o.JSONProducer
 
@@ -279,16 +343,46 @@ func (o *DeployWizardAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/app/bundle"] = apps.NewBundleApp(o.context, o.AppsBundleAppHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/health"] = general.NewGetHealth(o.context, o.GeneralGetHealthHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/app/import"] = apps.NewImportApp(o.context, o.AppsImportAppHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/templates"] = general.NewListTemplates(o.context, o.GeneralListTemplatesHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/app/spec"] = apps.NewLoadApp(o.context, o.AppsLoadAppHandler)
+
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/app/preview"] = apps.NewPreviewApp(o.context, o.AppsPreviewAppHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/app/preview/diff"] = apps.NewPreviewAppDiff(o.context, o.AppsPreviewAppDiffHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/app/preview/files"] = apps.NewPreviewAppFiles(o.context, o.AppsPreviewAppFilesHandler)
+
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
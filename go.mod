@@ -2,43 +2,34 @@ module deploy-wizard
 
 require (
 	github.com/andreyvit/diff v0.0.0-20170406064948-c7f18ee00883
-	github.com/cactus/go-statsd-client/statsd v0.0.0-20190302225832-f5dd73501f04 // indirect
-	github.com/carbocation/handlers v0.0.0-20140528190747-c939c6d9ef31 // indirect
 	github.com/carbocation/interpose v0.0.0-20161206215253-723534742ba3
 	github.com/codegangsta/inject v0.0.0-20150114235600-33e0aa1cb7c0 // indirect
-	github.com/docker/go-units v0.3.3 // indirect
 	github.com/emirpasic/gods v1.12.0 // indirect
+	github.com/fsnotify/fsnotify v1.5.4
 	github.com/gliderlabs/ssh v0.1.3 // indirect
 	github.com/go-martini/martini v0.0.0-20170121215854-22fa46961aab // indirect
 	github.com/go-openapi/errors v0.18.0
 	github.com/go-openapi/loads v0.17.0
 	github.com/go-openapi/runtime v0.0.0-20180920151709-4f900dc2ade9
-	github.com/go-openapi/spec v0.17.0
+	github.com/go-openapi/spec v0.19.3
 	github.com/go-openapi/strfmt v0.18.0
-	github.com/go-openapi/swag v0.18.0
+	github.com/go-openapi/swag v0.19.14
 	github.com/go-openapi/validate v0.18.0
-	github.com/goods/httpbuf v0.0.0-20120503183857-5709e9bb814c // indirect
 	github.com/jessevdk/go-flags v1.4.0
-	github.com/justinas/nosurf v0.0.0-20190118163749-6453469bdcc9 // indirect
-	github.com/kr/pty v1.1.4 // indirect
 	github.com/meatballhat/negroni-logrus v0.0.0-20170801195057-31067281800f
-	github.com/mitchellh/go-homedir v1.1.0 // indirect
-	github.com/phyber/negroni-gzip v0.0.0-20180113114010-ef6356a5d029 // indirect
-	github.com/pkg/errors v0.8.1
-	github.com/prometheus/client_golang v0.9.2
+	github.com/open-policy-agent/opa v0.42.2
+	github.com/pkg/errors v0.9.1
+	github.com/pmezard/go-difflib v1.0.0
+	github.com/prometheus/client_golang v1.12.2
 	github.com/rs/cors v1.6.0
-	github.com/sirupsen/logrus v1.4.0
-	github.com/stretchr/objx v0.2.0 // indirect
+	github.com/sirupsen/logrus v1.8.1
 	github.com/urfave/negroni v1.0.0 // indirect
 	github.com/xanzy/ssh-agent v0.2.1 // indirect
-	golang.org/x/crypto v0.0.0-20190424203555-c05e17bb3b2d // indirect
-	golang.org/x/net v0.0.0-20190424112056-4829fb13d2c6
-	golang.org/x/sys v0.0.0-20190424175732-18eb32c0e2f0 // indirect
-	golang.org/x/text v0.3.1 // indirect
-	golang.org/x/tools v0.0.0-20190425001055-9e44c1c40307 // indirect
+	golang.org/x/net v0.0.0-20220127200216-cd36cc0744dd
 	gopkg.in/src-d/go-billy.v4 v4.3.0
 	gopkg.in/src-d/go-git-fixtures.v3 v3.5.0 // indirect
 	gopkg.in/src-d/go-git.v4 v4.11.0
+	gopkg.in/yaml.v2 v2.4.0
 )
 
-go 1.13
+go 1.16
@@ -0,0 +1,40 @@
+package application
+
+import (
+	"path"
+	"strings"
+
+	"deploy-wizard/gen/models"
+)
+
+// RenderBundle renders the files a release of the application commits, keyed
+// by their path relative to the repository root: the manifests, the spec file
+// and the record of both under the destination path and the deploy specs of
+// its deploy target.
+// current reads the files already committed to the repository; it may be nil.
+func (r *Renderer) RenderBundle(app *models.Application, current FileReader) (map[string]string, error) {
+	manifests, err := r.RenderManifests(app)
+	if err != nil {
+		return nil, err
+	}
+
+	deploySpecs, err := r.RenderDeploySpecs(app, current)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := AddReleaseRecord(app, manifests); err != nil {
+		return nil, err
+	}
+
+	prefix := strings.TrimPrefix(app.Spec.Destination.Path, "/")
+	files := map[string]string{}
+	for filename, content := range manifests {
+		files[path.Join(prefix, filename)] = content
+	}
+	for filename, content := range deploySpecs {
+		files[filename] = content
+	}
+
+	return files, nil
+}
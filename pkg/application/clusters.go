@@ -0,0 +1,115 @@
+package application
+
+import (
+	"fmt"
+	"strings"
+
+	"deploy-wizard/gen/models"
+)
+
+const defaultClusterServer = "https://kubernetes.default.svc"
+
+// Cluster is an ArgoCD destination cluster for a region and environment
+type Cluster struct {
+	Region string `yaml:"region"`
+	Env    string `yaml:"env"`
+
+	// Server is the API server URL of the cluster. Mutually exclusive with Name.
+	Server string `yaml:"server,omitempty"`
+	// Name is the name of the cluster as registered in ArgoCD. Mutually exclusive with Server.
+	Name string `yaml:"name,omitempty"`
+	// Project is the ArgoCD project applications deployed to this cluster
+	// belong to. Defaults to the AppProject of the application's team, which
+	// a release generates.
+	Project string `yaml:"project,omitempty"`
+
+	// KubernetesVersion is the minor version the cluster runs, e.g. "1.22".
+	// Takes precedence over the version of the env.
+	KubernetesVersion string `yaml:"kubernetesVersion,omitempty"`
+
+	// KubeConfigSecret is the Secret in the flux-system namespace holding the
+	// kubeconfig Flux deploys to the cluster with. Defaults to
+	// <name>-kubeconfig for a cluster set by name.
+	KubeConfigSecret string `yaml:"kubeConfigSecret,omitempty"`
+}
+
+// FluxKubeConfigSecret returns the Secret holding the kubeconfig Flux deploys
+// to the cluster with. It is empty for the cluster Flux runs in. A cluster set
+// by the URL of another API server has none unless it is configured.
+func (c *Cluster) FluxKubeConfigSecret() (string, bool) {
+	switch {
+	case c.KubeConfigSecret != "":
+		return c.KubeConfigSecret, true
+	case c.Name != "":
+		return c.Name + "-kubeconfig", true
+	}
+	return "", c.Server == defaultClusterServer
+}
+
+// Clusters maps regions and environments to ArgoCD destination clusters
+type Clusters []*Cluster
+
+// defaultCluster is used when no clusters are configured at all
+var defaultCluster = &Cluster{Server: defaultClusterServer}
+
+// Lookup returns the cluster configured for the region and env. When no
+// clusters are configured, applications are deployed to the cluster ArgoCD
+// runs in.
+func (c Clusters) Lookup(region, env string) (*Cluster, error) {
+	if len(c) == 0 {
+		return defaultCluster, nil
+	}
+
+	for _, cluster := range c {
+		if strings.EqualFold(cluster.Region, region) && strings.EqualFold(cluster.Env, env) {
+			return cluster, nil
+		}
+	}
+
+	return nil, fmt.Errorf(errMsgNoCluster, region, env)
+}
+
+// Validate checks that every cluster has a region, env, exactly one of server
+// or name and, if set, a supported Kubernetes version
+func (c Clusters) Validate() error {
+	seen := map[string]struct{}{}
+	for i, cluster := range c {
+		if cluster.Region == "" || cluster.Env == "" {
+			return fmt.Errorf("cluster %d: region and env are required", i)
+		}
+		if (cluster.Server == "") == (cluster.Name == "") {
+			return fmt.Errorf("cluster %d: exactly one of server or name is required", i)
+		}
+		if cluster.Project != "" && !isValidDNSName(cluster.Project) {
+			return fmt.Errorf("cluster %d: project %q must be a valid AppProject name", i, cluster.Project)
+		}
+		if cluster.KubeConfigSecret != "" && !isValidDNSName(cluster.KubeConfigSecret) {
+			return fmt.Errorf("cluster %d: kubeConfigSecret %q must be a valid Secret name", i, cluster.KubeConfigSecret)
+		}
+		if cluster.KubernetesVersion != "" && !isKubernetesVersion(cluster.KubernetesVersion) {
+			return fmt.Errorf("cluster %d: unsupported Kubernetes version %q", i, cluster.KubernetesVersion)
+		}
+
+		key := strings.ToLower(cluster.Region + "/" + cluster.Env)
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("cluster %d: duplicate cluster for region %q and env %q", i, cluster.Region, cluster.Env)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateDeployTargets checks that Flux can deploy to the clusters of the
+// envs that deploy with flux
+func (c Clusters) ValidateDeployTargets(environments Environments) error {
+	for i, cluster := range c {
+		environment := environments.Lookup(cluster.Env)
+		if environment == nil || environment.DeployTarget != models.DestinationDeployTargetFlux {
+			continue
+		}
+		if _, ok := cluster.FluxKubeConfigSecret(); !ok {
+			return fmt.Errorf("cluster %d: env %q deploys with flux, which needs a kubeConfigSecret for server %q", i, cluster.Env, cluster.Server)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package application_test
+
+import (
+	"strings"
+	"testing"
+
+	"deploy-wizard/gen/models"
+	"deploy-wizard/pkg/application"
+)
+
+var testClusters = application.Clusters{
+	{Region: "STL", Env: "Dev", Server: "https://stl-dev.mc.int:6443"},
+	{Region: "KCI", Env: "Prod", Name: "kci-prod"},
+}
+
+func TestClustersLookup(t *testing.T) {
+	cluster, err := testClusters.Lookup("stl", "dev")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cluster.Server != "https://stl-dev.mc.int:6443" {
+		t.Errorf("expected the STL/Dev cluster, got %+v", cluster)
+	}
+
+	if _, err := testClusters.Lookup("BEL", "Dev"); err == nil {
+		t.Error("expected an error for an unmapped region")
+	}
+
+	cluster, err = application.Clusters{}.Lookup("BEL", "Dev")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cluster.Server != "https://kubernetes.default.svc" {
+		t.Errorf("expected the in-cluster default, got %+v", cluster)
+	}
+}
+
+func TestClustersValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		clusters application.Clusters
+	}{
+		{"missing env", application.Clusters{{Region: "STL", Server: "https://a"}}},
+		{"server and name", application.Clusters{{Region: "STL", Env: "Dev", Server: "https://a", Name: "a"}}},
+		{"no server or name", application.Clusters{{Region: "STL", Env: "Dev"}}},
+		{"unsupported kubernetes version", application.Clusters{{Region: "STL", Env: "Dev", Server: "https://a", KubernetesVersion: "1.0"}}},
+		{"invalid project", application.Clusters{{Region: "STL", Env: "Dev", Server: "https://a", Project: "Team Project"}}},
+		{"invalid kubeconfig secret", application.Clusters{{Region: "STL", Env: "Dev", Server: "https://a", KubeConfigSecret: "-"}}},
+		{"duplicate", application.Clusters{
+			{Region: "STL", Env: "Dev", Server: "https://a"},
+			{Region: "stl", Env: "dev", Name: "b"},
+		}},
+	}
+
+	for _, tt := range tests {
+		if err := tt.clusters.Validate(); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+
+	if err := testClusters.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRenderDeploySpecCluster(t *testing.T) {
+	app := application.ApplyDefaults(validApplication)
+
+	renderer, err := application.NewRenderer("../../_templates", application.WithClusters(testClusters))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := renderer.RenderDeploySpec(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"server: https://stl-dev.mc.int:6443",
+		"project: tenant1",
+	} {
+		if !strings.Contains(result, want) {
+			t.Errorf("expected %q in deploy spec:\n%s", want, result)
+		}
+	}
+
+	app.Metadata.Labels.Region = "BEL"
+	defer func() { app.Metadata.Labels.Region = "STL" }()
+	if _, err := renderer.RenderDeploySpec(app); err == nil {
+		t.Error("expected an error for an unmapped region")
+	}
+}
+
+func TestValidateApplicationCluster(t *testing.T) {
+	app := application.ApplyDefaults(validApplication)
+
+	if errs := application.ValidateApplication(app, application.RequireCluster(testClusters)); len(errs) > 0 {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+
+	app.Metadata.Labels.Region = "BEL"
+	defer func() { app.Metadata.Labels.Region = "STL" }()
+
+	errs := application.ValidateApplication(app, application.RequireCluster(testClusters))
+	md, _ := errs["metadata"].(map[string]interface{})
+	labels, _ := md["labels"].(map[string]interface{})
+	if _, ok := labels["region"]; !ok {
+		t.Errorf("expected a region error, got %v", errs)
+	}
+}
+
+func TestRenderDeploySpecsClusterProject(t *testing.T) {
+	clusters := append(application.Clusters{{Region: "STL", Env: "Prod", Name: "stl-prod", Project: "prod"}}, testClusters...)
+	renderer, err := application.NewRenderer("../../_templates", application.WithClusters(clusters))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the project of the cluster takes precedence over the team project
+	app := newDeterminismApplication(models.DestinationFormatKustomize)
+	app.Metadata.Labels.Env = "Prod"
+	results, err := renderer.RenderDeploySpecs(app, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := results["apps/app1.yaml"]; !strings.Contains(result, "  project: prod\n") {
+		t.Errorf("expected the project of the cluster in deploy spec:\n%s", result)
+	}
+	if _, ok := results["projects/tenant1.yaml"]; ok || len(results) != 1 {
+		t.Errorf("expected no team AppProject, got %v", results)
+	}
+
+	// a cluster without a project deploys to the team project
+	app.Metadata.Labels.Env = "Dev"
+	results, err = renderer.RenderDeploySpecs(app, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := results["apps/app1.yaml"]; !strings.Contains(result, "  project: tenant1\n") {
+		t.Errorf("expected the team project in deploy spec:\n%s", result)
+	}
+	if _, ok := results["projects/tenant1.yaml"]; !ok {
+		t.Errorf("expected the team AppProject, got %v", results)
+	}
+}
+
+func TestValidateTeamProject(t *testing.T) {
+	clusters := append(application.Clusters{{Region: "STL", Env: "Prod", Name: "stl-prod", Project: "prod"}}, testClusters...)
+
+	app := newDeterminismApplication(models.DestinationFormatKustomize)
+	app.Metadata.Labels.Team = "Payments_Core"
+	if got := validationError(application.ValidateTeamProject(app, clusters), "metadata.labels.team"); !strings.HasPrefix(got, `team "Payments_Core" must name an ArgoCD project`) {
+		t.Errorf("expected a team project error, got %q", got)
+	}
+
+	// a cluster with a project or flux commits no team project
+	app.Metadata.Labels.Env = "Prod"
+	if errs := application.ValidateTeamProject(app, clusters); len(errs) > 0 {
+		t.Errorf("expected no errors for a cluster with a project, got %v", errs)
+	}
+	app.Metadata.Labels.Env = "Dev"
+	app.Spec.Destination.DeployTarget = models.DestinationDeployTargetFlux
+	if errs := application.ValidateTeamProject(app, clusters); len(errs) > 0 {
+		t.Errorf("expected no errors for flux, got %v", errs)
+	}
+
+	app.Spec.Destination.DeployTarget = ""
+	app.Metadata.Labels.Team = "Payments"
+	if errs := application.ValidateTeamProject(app, clusters); len(errs) > 0 {
+		t.Errorf("expected a team that lowercases to a project name to be valid, got %v", errs)
+	}
+}
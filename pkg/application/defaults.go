@@ -7,8 +7,45 @@ import (
 const (
 	defaultApplicationTargetRevision = "HEAD"
 	defaultApplicationPath           = "/"
+	syncOptionCreateNamespace        = "CreateNamespace=true"
+	defaultComponentReplicas         = 1
 )
 
+// defaultSyncPolicies are the ArgoCD sync policies applied per env when the
+// application does not specify one. Prod is synced manually.
+var defaultSyncPolicies = map[string]func() *models.SyncPolicy{
+	models.LabelsEnvDev: func() *models.SyncPolicy {
+		return &models.SyncPolicy{
+			Automated:   &models.SyncPolicyAutomated{Prune: true, SelfHeal: true},
+			SyncOptions: []string{syncOptionCreateNamespace},
+			Retry:       defaultRetryStrategy(),
+		}
+	},
+	models.LabelsEnvStage: func() *models.SyncPolicy {
+		return &models.SyncPolicy{
+			Automated:   &models.SyncPolicyAutomated{SelfHeal: true},
+			SyncOptions: []string{syncOptionCreateNamespace},
+			Retry:       defaultRetryStrategy(),
+		}
+	},
+	models.LabelsEnvProd: func() *models.SyncPolicy {
+		return &models.SyncPolicy{
+			SyncOptions: []string{syncOptionCreateNamespace},
+		}
+	},
+}
+
+func defaultRetryStrategy() *models.RetryStrategy {
+	return &models.RetryStrategy{
+		Limit: 5,
+		Backoff: &models.Backoff{
+			Duration:    "5s",
+			Factor:      2,
+			MaxDuration: "3m",
+		},
+	}
+}
+
 // ApplyDefaults applies defaults to the Application model
 func ApplyDefaults(app *models.Application) *models.Application {
 	destination := app.Spec.Destination
@@ -18,8 +55,16 @@ func ApplyDefaults(app *models.Application) *models.Application {
 	if destination.Path == "" {
 		destination.Path = defaultApplicationPath
 	}
+	if destination.SyncPolicy == nil && app.Metadata != nil && app.Metadata.Labels != nil {
+		if syncPolicy, ok := defaultSyncPolicies[app.Metadata.Labels.Env]; ok {
+			destination.SyncPolicy = syncPolicy()
+		}
+	}
 
 	for _, component := range app.Spec.Components {
+		if component.Replicas == 0 {
+			component.Replicas = defaultComponentReplicas
+		}
 		applyServiceDefaults(component.Service)
 	}
 
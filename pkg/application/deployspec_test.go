@@ -0,0 +1,455 @@
+package application_test
+
+import (
+	"strings"
+	"testing"
+
+	"deploy-wizard/gen/models"
+	"deploy-wizard/pkg/application"
+
+	"github.com/andreyvit/diff"
+	"github.com/go-openapi/strfmt"
+)
+
+var expectedDeploySpec = `apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+	name: app1
+	namespace: argocd
+spec:
+	destination:
+		namespace: tenant1
+		server: https://kubernetes.default.svc
+	project: tenant1
+	source:
+		path: overlays/dev
+		repoURL: https://internalscm/stash/scm/ce/fake-repo.git/
+		targetRevision: HEAD
+	syncPolicy:
+		automated:
+			prune: true
+			selfHeal: true
+		syncOptions:
+		- CreateNamespace=true
+		retry:
+			limit: 5
+			backoff:
+				duration: 5s
+				factor: 2
+				maxDuration: 3m
+`
+
+func TestRenderDeploySpec(t *testing.T) {
+	app := application.ApplyDefaults(validApplication)
+	defer func() { app.Spec.Destination.SyncPolicy = nil }()
+
+	renderer, err := application.NewRenderer("../../_templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := renderer.RenderDeploySpec(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := strings.Replace(expectedDeploySpec, "\t", "  ", -1)
+	if result != expected {
+		t.Errorf("Result not as expected:\n%v", diff.LineDiff(result, expected))
+	}
+}
+
+func TestRenderDeploySpecProd(t *testing.T) {
+	app := application.ApplyDefaults(&models.Application{
+		Metadata: &models.Metadata{
+			Name:      "app1",
+			Namespace: "tenant1",
+			Labels:    &models.Labels{Version: "v1", Team: "tenant1", Env: "Prod", Region: "STL"},
+		},
+		Spec: &models.Spec{
+			Destination: &models.Destination{
+				URL:            "https://internalscm/stash/scm/ce/fake-repo.git/",
+				TargetRevision: "release-1.0",
+				Finalizers:     []string{"resources-finalizer.argocd.argoproj.io"},
+				IgnoreDifferences: []*models.IgnoreDifference{
+					{Group: "apps", Kind: "Deployment", JSONPointers: []string{"/spec/replicas"}},
+				},
+			},
+		},
+	})
+
+	renderer, err := application.NewRenderer("../../_templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := renderer.RenderDeploySpec(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(result, "automated:") {
+		t.Errorf("expected Prod to be synced manually:\n%s", result)
+	}
+
+	for _, want := range []string{
+		"targetRevision: release-1.0",
+		"  finalizers:\n  - resources-finalizer.argocd.argoproj.io\n",
+		"  syncOptions:\n    - CreateNamespace=true\n",
+		"  ignoreDifferences:\n  - kind: Deployment\n    group: apps\n    jsonPointers:\n    - /spec/replicas\n",
+	} {
+		if !strings.Contains(result, want) {
+			t.Errorf("expected %q in deploy spec:\n%s", want, result)
+		}
+	}
+}
+
+func TestValidateSyncPolicy(t *testing.T) {
+	errs := application.ValidateSyncPolicy(&models.SyncPolicy{
+		SyncOptions: []string{"CreateNamespace=true", "Prune"},
+		Retry: &models.RetryStrategy{
+			Limit:   -1,
+			Backoff: &models.Backoff{Duration: "5 seconds", MaxDuration: "3m"},
+		},
+	})
+
+	options, _ := errs["syncOptions"].(map[string]interface{})
+	if _, ok := options["1"]; !ok || len(options) != 1 {
+		t.Errorf("expected only the second sync option to be invalid, got %v", errs)
+	}
+
+	retry, _ := errs["retry"].(map[string]interface{})
+	if _, ok := retry["limit"]; !ok {
+		t.Errorf("expected a retry limit error, got %v", errs)
+	}
+	backoff, _ := retry["backoff"].(map[string]interface{})
+	if _, ok := backoff["duration"]; !ok || len(backoff) != 1 {
+		t.Errorf("expected only a backoff duration error, got %v", errs)
+	}
+}
+
+func TestValidateSyncPolicyBackoffFactor(t *testing.T) {
+	for factor, valid := range map[int64]bool{-1: false, 0: true, 1: true, 2: true} {
+		backoff := &models.Backoff{Factor: factor}
+		errs := application.ValidateSyncPolicy(&models.SyncPolicy{Retry: &models.RetryStrategy{Backoff: backoff}})
+		if got := validationError(errs, "retry.backoff.factor"); (got == "") != valid {
+			t.Errorf("factor %d: expected valid to be %t, got %q", factor, valid, got)
+		}
+		if err := backoff.Validate(strfmt.Default); (err == nil) != valid {
+			t.Errorf("factor %d: expected the schema to agree, got %v", factor, err)
+		}
+	}
+}
+
+var expectedProjectSpec = `apiVersion: argoproj.io/v1alpha1
+kind: AppProject
+metadata:
+	name: tenant1
+	namespace: argocd
+spec:
+	description: Kruise applications of team tenant1
+	sourceRepos:
+	- https://internalscm/stash/scm/ce/fake-repo.git/
+	destinations:
+	- namespace: tenant1
+		server: https://kubernetes.default.svc
+	clusterResourceWhitelist:
+	- group: ""
+		kind: Namespace
+`
+
+func TestRenderProjectSpec(t *testing.T) {
+	app := application.ApplyDefaults(validApplication)
+
+	renderer, err := application.NewRenderer("../../_templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := renderer.RenderProjectSpec(app, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := strings.Replace(expectedProjectSpec, "\t", "  ", -1)
+	if result != expected {
+		t.Errorf("Result not as expected:\n%v", diff.LineDiff(result, expected))
+	}
+}
+
+func TestRenderProjectSpecMerge(t *testing.T) {
+	app := application.ApplyDefaults(validApplication)
+
+	renderer, err := application.NewRenderer("../../_templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	current := `apiVersion: argoproj.io/v1alpha1
+kind: AppProject
+metadata:
+  name: tenant1
+spec:
+  sourceRepos:
+  - https://internalscm/stash/scm/ce/other-repo.git/
+  - https://internalscm/stash/scm/ce/fake-repo.git/
+  destinations:
+  - namespace: tenant1-batch
+    name: kci-prod
+  - namespace: tenant1
+    server: https://kubernetes.default.svc
+`
+	result, err := renderer.RenderProjectSpec(app, current)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"  sourceRepos:\n  - https://internalscm/stash/scm/ce/fake-repo.git/\n  - https://internalscm/stash/scm/ce/other-repo.git/\n",
+		"  destinations:\n  - namespace: tenant1\n    server: https://kubernetes.default.svc\n  - namespace: tenant1-batch\n    name: kci-prod\n",
+	} {
+		if !strings.Contains(result, want) {
+			t.Errorf("expected %q in project spec:\n%s", want, result)
+		}
+	}
+
+	if _, err := renderer.RenderProjectSpec(app, "spec: ["); err == nil {
+		t.Error("expected an error for an unparseable AppProject")
+	}
+}
+
+func TestRenderDeploySpecsArgoCD(t *testing.T) {
+	app := application.ApplyDefaults(validApplication)
+
+	renderer, err := application.NewRenderer("../../_templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := renderer.RenderDeploySpecs(app, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, filename := range []string{"apps/app1.yaml", "projects/tenant1.yaml"} {
+		if _, ok := results[filename]; !ok {
+			t.Errorf("%s not found", filename)
+		}
+	}
+	if len(results) != 2 {
+		t.Errorf("expected 2 deploy specs, got %d", len(results))
+	}
+}
+
+var expectedFluxDeploySpec = `apiVersion: source.toolkit.fluxcd.io/v1
+kind: GitRepository
+metadata:
+	name: app1
+	namespace: flux-system
+spec:
+	interval: 1m
+	url: https://internalscm/stash/scm/ce/fake-repo.git/
+	ref:
+		branch: release-1.0
+---
+apiVersion: kustomize.toolkit.fluxcd.io/v1
+kind: Kustomization
+metadata:
+	name: app1
+	namespace: flux-system
+spec:
+	interval: 5m
+	retryInterval: 5s
+	path: ./overlays/dev
+	prune: true
+	sourceRef:
+		kind: GitRepository
+		name: app1
+	targetNamespace: tenant1
+`
+
+func TestRenderDeploySpecsFlux(t *testing.T) {
+	app := application.ApplyDefaults(validApplication)
+	app.Spec.Destination.DeployTarget = "flux"
+	app.Spec.Destination.TargetRevision = "release-1.0"
+	defer func() {
+		app.Spec.Destination.DeployTarget = ""
+		app.Spec.Destination.TargetRevision = "HEAD"
+	}()
+
+	renderer, err := application.NewRenderer("../../_templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := renderer.RenderDeploySpecs(app, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 1 {
+		t.Errorf("expected 1 deploy spec, got %v", results)
+	}
+
+	expected := strings.Replace(expectedFluxDeploySpec, "\t", "  ", -1)
+	if result := results["apps/app1.yaml"]; result != expected {
+		t.Errorf("Result not as expected:\n%v", diff.LineDiff(result, expected))
+	}
+}
+
+func TestRenderDeploySpecsFluxProd(t *testing.T) {
+	app := application.ApplyDefaults(&models.Application{
+		Metadata: &models.Metadata{
+			Name:      "app1",
+			Namespace: "tenant1",
+			Labels:    &models.Labels{Version: "v1", Team: "tenant1", Env: "Prod", Region: "KCI"},
+		},
+		Spec: &models.Spec{
+			Destination: &models.Destination{
+				URL:            "https://internalscm/stash/scm/ce/fake-repo.git/",
+				Path:           "/deploy",
+				TargetRevision: "0123456789abcdef0123456789abcdef01234567",
+			},
+		},
+	})
+
+	renderer, err := application.NewRenderer("../../_templates",
+		application.WithClusters(testClusters),
+		application.WithEnvironments(application.Environments{{Env: "Prod", DeployTarget: "flux"}}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if target := renderer.DeployTarget(app); target != "flux" {
+		t.Errorf("expected the env deploy target flux, got %q", target)
+	}
+
+	results, err := renderer.RenderDeploySpecs(app, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := results["apps/app1.yaml"]
+	for _, want := range []string{
+		"    commit: 0123456789abcdef0123456789abcdef01234567\n",
+		"  path: ./deploy/overlays/prod\n",
+		"  prune: false\n  suspend: true\n",
+		"  kubeConfig:\n    secretRef:\n      name: kci-prod-kubeconfig\n",
+	} {
+		if !strings.Contains(result, want) {
+			t.Errorf("expected %q in deploy spec:\n%s", want, result)
+		}
+	}
+}
+
+func TestRenderFluxServerCluster(t *testing.T) {
+	clusters := application.Clusters{
+		{Region: "STL", Env: "Dev", Server: "https://stl-dev.mc.int:6443", KubeConfigSecret: "stl-dev"},
+		{Region: "STL", Env: "Prod", Server: "https://kubernetes.default.svc"},
+	}
+	renderer, err := application.NewRenderer("../../_templates", application.WithClusters(clusters))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for env, want := range map[string]string{
+		"Dev":  "  kubeConfig:\n    secretRef:\n      name: stl-dev\n",
+		"Prod": "",
+	} {
+		app := newDeterminismApplication(models.DestinationFormatKustomize)
+		app.Metadata.Labels.Env = env
+		app.Spec.Destination.DeployTarget = models.DestinationDeployTargetFlux
+
+		results, err := renderer.RenderDeploySpecs(app, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result := results["apps/app1.yaml"]
+		if want == "" && strings.Contains(result, "kubeConfig:") {
+			t.Errorf("%s: expected Flux to deploy to its own cluster:\n%s", env, result)
+		} else if !strings.Contains(result, want) {
+			t.Errorf("%s: expected %q in deploy spec:\n%s", env, want, result)
+		}
+	}
+}
+
+func TestFluxClusterWithoutKubeConfig(t *testing.T) {
+	environments := application.Environments{{Env: "Dev", DeployTarget: "flux"}}
+	if err := testClusters.ValidateDeployTargets(environments); err == nil || !strings.Contains(err.Error(), "kubeConfigSecret") {
+		t.Errorf("expected an error for the server cluster of an env that deploys with flux, got %v", err)
+	}
+	if _, err := application.NewRenderer("../../_templates", application.WithClusters(testClusters), application.WithEnvironments(environments)); err == nil {
+		t.Error("expected the renderer to reject the configuration")
+	}
+
+	app := newDeterminismApplication(models.DestinationFormatKustomize)
+	app.Spec.Destination.DeployTarget = models.DestinationDeployTargetFlux
+	errs := application.ValidateApplication(app, application.RequireCluster(testClusters))
+	if message := validationError(errs, "spec.destination.deployTarget"); !strings.Contains(message, "https://stl-dev.mc.int:6443") {
+		t.Errorf("expected a deploy target error, got %v", errs)
+	}
+
+	renderer, err := application.NewRenderer("../../_templates", application.WithClusters(testClusters))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := renderer.RenderDeploySpecs(app, nil); err == nil {
+		t.Error("expected an error rendering the Flux Kustomization")
+	}
+}
+
+func TestEnvironmentsValidate(t *testing.T) {
+	if err := (application.Environments{{Env: "Dev", DeployTarget: "spinnaker"}}).Validate(); err == nil {
+		t.Error("expected an error for an unknown deploy target")
+	}
+	if err := (application.Environments{{Env: "Dev"}, {Env: "dev"}}).Validate(); err == nil {
+		t.Error("expected an error for a duplicate env")
+	}
+	if err := (application.Environments{{Env: "Dev", KubernetesVersion: "1.0"}}).Validate(); err == nil {
+		t.Error("expected an error for an unsupported Kubernetes version")
+	}
+	if err := (application.Environments{{Env: "Dev", DeployTarget: "flux"}}).Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRenderBundle(t *testing.T) {
+	app := application.ApplyDefaults(validApplication)
+	app.Spec.Destination.Path = "/deploy"
+	defer func() { app.Spec.Destination.Path = "/" }()
+
+	renderer, err := application.NewRenderer("../../_templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := renderer.RenderBundle(app, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, filename := range []string{
+		"deploy/base/kustomization.yaml",
+		"deploy/overlays/dev/kustomization.yaml",
+		"apps/app1.yaml",
+		"projects/tenant1.yaml",
+	} {
+		if _, ok := files[filename]; !ok {
+			t.Errorf("%s not found in %v", filename, files)
+		}
+	}
+
+	recorded, err := application.LoadFiles("/deploy", func(filename string) (string, error) { return files[filename], nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stale := application.StaleFiles(app, files, recorded); len(stale) > 0 {
+		t.Errorf("expected every recorded file in the bundle, got %v", stale)
+	}
+	for _, filename := range recorded {
+		if strings.HasPrefix(filename, "apps/") || strings.HasPrefix(filename, "projects/") {
+			t.Errorf("expected only the files under the destination path to be recorded, got %s", filename)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package application
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"deploy-wizard/gen/models"
+)
+
+// FileReader reads a file relative to the root of the GitOps repository. It
+// returns an error satisfying os.IsNotExist when the file does not exist.
+type FileReader func(filename string) (string, error)
+
+// deployTarget renders the resources a continuous delivery tool needs to
+// deploy an application from the GitOps repository
+type deployTarget interface {
+	// renderDeploySpecs returns the rendered files keyed by their path
+	// relative to the repository root
+	renderDeploySpecs(r *Renderer, app *models.Application, current FileReader) (map[string]string, error)
+}
+
+// deployTargets are the supported deploy targets by name
+var deployTargets = map[string]deployTarget{
+	models.DestinationDeployTargetArgocd: argoCDTarget{},
+	models.DestinationDeployTargetFlux:   fluxTarget{},
+}
+
+const defaultDeployTarget = models.DestinationDeployTargetArgocd
+
+// DeployTarget returns the name of the deploy target of the application. It
+// is selected by the application, then by its env, and defaults to argocd.
+func (r *Renderer) DeployTarget(app *models.Application) string {
+	if target := app.Spec.Destination.DeployTarget; target != "" {
+		return target
+	}
+	if env := r.environments.Lookup(app.Metadata.Labels.Env); env != nil && env.DeployTarget != "" {
+		return env.DeployTarget
+	}
+	return defaultDeployTarget
+}
+
+// RenderDeploySpecs renders the deploy specs of the application's deploy
+// target, keyed by their path relative to the repository root. current reads
+// the files already committed to the repository; it may be nil.
+func (r *Renderer) RenderDeploySpecs(app *models.Application, current FileReader) (map[string]string, error) {
+	name := r.DeployTarget(app)
+	target, ok := deployTargets[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown deploy target %q", name)
+	}
+
+	if current == nil {
+		current = func(string) (string, error) { return "", os.ErrNotExist }
+	}
+
+	return target.renderDeploySpecs(r, app, current)
+}
+
+// deploySpecFile returns the path of the application's deploy spec in the repository
+func deploySpecFile(app *models.Application) string {
+	return fmt.Sprintf("apps/%s.yaml", strings.ToLower(app.Metadata.Name))
+}
+
+type argoCDTarget struct{}
+
+func (argoCDTarget) renderDeploySpecs(r *Renderer, app *models.Application, current FileReader) (map[string]string, error) {
+	deploySpec, err := r.RenderDeploySpec(app)
+	if err != nil {
+		return nil, err
+	}
+
+	// the project of a cluster is managed outside of the repository
+	cluster, err := r.clusters.Lookup(app.Metadata.Labels.Region, app.Metadata.Labels.Env)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.Project != "" {
+		return map[string]string{deploySpecFile(app): deploySpec}, nil
+	}
+
+	projectSpecFile := fmt.Sprintf("projects/%s.yaml", ProjectName(app))
+	currentProjectSpec, err := current(projectSpecFile)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	projectSpec, err := r.RenderProjectSpec(app, currentProjectSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{
+		deploySpecFile(app): deploySpec,
+		projectSpecFile:     projectSpec,
+	}, nil
+}
@@ -0,0 +1,159 @@
+package application_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"deploy-wizard/gen/models"
+	"deploy-wizard/pkg/application"
+)
+
+// renders is how often the determinism tests render the same application.
+// Go randomizes map iteration, so an order taken from a map shows up as a
+// difference within a few renders.
+const renders = 20
+
+func newDeterminismApplication(format string) *models.Application {
+	volumes := []*models.VolumeMount{
+		{MountPath: "/config/zeta", Name: "zeta", Type: models.VolumeMountTypeConfigMap},
+		{MountPath: "/config/alpha", Name: "alpha", Type: models.VolumeMountTypeConfigMap},
+		{MountPath: "/config/mid", Name: "mid", Type: models.VolumeMountTypeConfigMap},
+		{MountPath: "/data/logs", Name: "logs", Type: models.VolumeMountTypePersistentVolume},
+		{MountPath: "/data/cache", Name: "cache", Type: models.VolumeMountTypePersistentVolume},
+	}
+
+	var configMaps []*models.ConfigMap
+	var persistentVolumes []*models.PersistentVolume
+	for _, volume := range volumes {
+		if volume.Type == models.VolumeMountTypeConfigMap {
+			configMaps = append(configMaps, &models.ConfigMap{Name: volume.Name, Data: "key: value"})
+			continue
+		}
+		persistentVolumes = append(persistentVolumes, &models.PersistentVolume{
+			Name:             volume.Name,
+			Capacity:         1,
+			AccessMode:       models.PersistentVolumeAccessModeReadWriteOnce,
+			StorageClassName: "SSD",
+		})
+	}
+
+	var components []*models.Component
+	for _, name := range []string{"web", "api", "worker"} {
+		components = append(components, &models.Component{
+			Service: &models.Service{
+				Name:  name,
+				Ports: []*models.ServicePort{{Name: "http", Port: 8080}},
+			},
+			Containers: []*models.Container{
+				{Name: name, Image: "nginx", ImageTag: "1.19", PortNames: []string{"http"}, Volumes: volumes},
+			},
+			Ingresses: []*models.Ingress{
+				{Host: name + ".mc.int", Paths: []*models.IngressPath{{Path: "/", PortName: "http"}}},
+			},
+		})
+	}
+
+	app := &models.Application{
+		Metadata: &models.Metadata{
+			Name:      "app1",
+			Namespace: "tenant1",
+			Labels:    &models.Labels{Version: "v1", Team: "tenant1", Env: "Dev", Region: "STL"},
+		},
+		Spec: &models.Spec{
+			Destination: &models.Destination{
+				URL:    "https://internalscm/stash/scm/ce/fake-repo.git/",
+				Format: format,
+			},
+			ConfigMaps:        configMaps,
+			PersistentVolumes: persistentVolumes,
+			Components:        components,
+			Overlays: []*models.Overlay{
+				{
+					Env: "Prod",
+					Components: []*models.ComponentOverlay{
+						{Name: "worker", Replicas: 2},
+						{Name: "web", Replicas: 3, Ingresses: []*models.IngressOverlay{{Host: "web.mc.int", NewHost: "web.prod.mc.int"}}},
+					},
+				},
+			},
+		},
+	}
+	if format == models.DestinationFormatKustomize {
+		app.Spec.Kustomize = &models.Kustomize{
+			CommonLabels:      map[string]string{"team": "tenant1", "cost-center": "42", "app": "app1", "tier": "web"},
+			CommonAnnotations: map[string]string{"owner": "tenant1@mc.int", "contact": "#tenant1"},
+		}
+	}
+	return application.ApplyDefaults(app)
+}
+
+// assertDeterministic renders an application repeatedly and fails if any
+// render differs from the first
+func assertDeterministic(t *testing.T, name string, render func() (interface{}, error)) {
+	first, err := render()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 1; i < renders; i++ {
+		result, err := render()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(result, first) {
+			t.Errorf("%s: render %d differs from the first:\n%v\n%v", name, i, result, first)
+			return
+		}
+	}
+}
+
+func TestRenderDeterministic(t *testing.T) {
+	renderer, err := application.NewRenderer("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, format := range []string{models.DestinationFormatKustomize, models.DestinationFormatHelm} {
+		app := newDeterminismApplication(format)
+
+		assertDeterministic(t, format+" manifests", func() (interface{}, error) {
+			return renderer.RenderManifests(app)
+		})
+		assertDeterministic(t, format+" application", func() (interface{}, error) {
+			return renderer.RenderApplication(app)
+		})
+		assertDeterministic(t, format+" deploy specs", func() (interface{}, error) {
+			return renderer.RenderDeploySpecs(app, nil)
+		})
+		assertDeterministic(t, format+" bundle", func() (interface{}, error) {
+			return renderer.RenderBundle(app, nil)
+		})
+		assertDeterministic(t, format+" preview", func() (interface{}, error) {
+			return renderer.RenderPreview(app)
+		})
+	}
+}
+
+func TestRenderSortedVolumes(t *testing.T) {
+	renderer, err := application.NewRenderer("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manifests, err := renderer.RenderManifests(newDeterminismApplication(models.DestinationFormatKustomize))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deployment := manifests["base/deployment-web.yaml"]
+	var last int
+	for _, name := range []string{"name: alpha", "name: mid", "name: zeta", "name: cache", "name: logs"} {
+		i := strings.Index(deployment, name)
+		if i < last {
+			t.Errorf("expected the volumes in sorted order, %q is out of place:\n%s", name, deployment)
+			return
+		}
+		last = i
+	}
+}
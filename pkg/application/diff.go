@@ -0,0 +1,123 @@
+package application
+
+import (
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"deploy-wizard/gen/models"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+const diffContextLines = 3
+
+// StaleFiles returns the files the previous release recorded under the
+// destination path of the application that its release no longer renders,
+// relative to the repository root. files are the rendered files, relative to
+// the repository root, and recorded the files of the previous release,
+// relative to the destination path.
+func StaleFiles(app *models.Application, files map[string]string, recorded []string) []string {
+	prefix := strings.Trim(app.Spec.Destination.Path, "/")
+
+	var stale []string
+	for _, filename := range recorded {
+		filename = path.Join(prefix, filename)
+		if _, ok := files[filename]; !ok {
+			stale = append(stale, filename)
+		}
+	}
+	sort.Strings(stale)
+	return stale
+}
+
+// Diff returns the unified diff of each file a release changes: the rendered
+// files that differ from their committed content and the stale files, which
+// are deleted when prune is set. current reads the committed files.
+func Diff(files map[string]string, stale []string, prune bool, current FileReader) ([]*models.FileDiff, error) {
+	var filenames []string
+	for filename := range files {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	var diffs []*models.FileDiff
+	for _, filename := range filenames {
+		committed, err := current(filename)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+
+		fileDiff := &models.FileDiff{Name: filename, Status: models.FileDiffStatusModified}
+		if os.IsNotExist(err) {
+			fileDiff.Status = models.FileDiffStatusAdded
+		} else if committed == files[filename] {
+			continue
+		}
+
+		fileDiff.Diff, err = unifiedDiff(filename, committed, files[filename], fileDiff.Status)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, fileDiff)
+	}
+
+	for _, filename := range stale {
+		committed, err := current(filename)
+		if os.IsNotExist(err) {
+			// already removed by hand
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !prune {
+			diffs = append(diffs, &models.FileDiff{Name: filename, Status: models.FileDiffStatusStale})
+			continue
+		}
+
+		fileDiff := &models.FileDiff{Name: filename, Status: models.FileDiffStatusDeleted}
+		fileDiff.Diff, err = unifiedDiff(filename, committed, "", fileDiff.Status)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, fileDiff)
+	}
+
+	return diffs, nil
+}
+
+func unifiedDiff(filename, from, to, status string) (string, error) {
+	fromFile, toFile := path.Join("a", filename), path.Join("b", filename)
+	switch status {
+	case models.FileDiffStatusAdded:
+		fromFile = "/dev/null"
+	case models.FileDiffStatusDeleted:
+		toFile = "/dev/null"
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(from),
+		B:        splitLines(to),
+		FromFile: fromFile,
+		ToFile:   toFile,
+		Context:  diffContextLines,
+	})
+}
+
+// splitLines splits content into lines that each end with a newline
+func splitLines(content string) []string {
+	if content == "" {
+		return nil
+	}
+
+	lines := strings.SplitAfter(content, "\n")
+	if last := len(lines) - 1; lines[last] == "" {
+		lines = lines[:last]
+	} else {
+		lines[last] += "\n"
+	}
+	return lines
+}
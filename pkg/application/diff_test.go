@@ -0,0 +1,137 @@
+package application_test
+
+import (
+	"os"
+	"testing"
+
+	"deploy-wizard/gen/models"
+	"deploy-wizard/pkg/application"
+)
+
+func TestDiff(t *testing.T) {
+	app := application.ApplyDefaults(validApplication)
+	app.Spec.Destination.Path = "/deploy"
+	defer func() { app.Spec.Destination.Path = "/" }()
+
+	committed := map[string]string{
+		"deploy/base/service-account.yaml":  "kind: ServiceAccount\n",
+		"deploy/base/service-app2.yaml":     "kind: Service\n",
+		"deploy/base/kustomization.yaml":    "resources:\n- service-account.yaml\n- service-app1.yaml\n",
+		"deploy/README.md":                  "# deploy\n",
+		"deploy/overlays/prod/patch.yaml":   "kind: Deployment\n",
+		"other/base/service-app1.yaml":      "kind: Service\n",
+		"deploy/base/service-app1.yaml":     "kind: Service\n",
+		"deploy/overlays/dev/kustomization": "resources: []\n",
+	}
+	current := func(filename string) (string, error) {
+		if content, ok := committed[filename]; ok {
+			return content, nil
+		}
+		return "", os.ErrNotExist
+	}
+
+	files := map[string]string{
+		"deploy/base/service-account.yaml": "kind: ServiceAccount\n",
+		"deploy/base/service-app1.yaml":    "kind: Service\nmetadata:\n  name: app1\n",
+		"deploy/base/kustomization.yaml":   "resources:\n- service-account.yaml\n- service-app1.yaml\n",
+		"apps/app1.yaml":                   "kind: Application\n",
+	}
+
+	// README.md and the files of other paths were not committed by a release
+	recorded := []string{
+		"base/service-account.yaml",
+		"base/service-app1.yaml",
+		"base/service-app2.yaml",
+		"base/kustomization.yaml",
+		"overlays/dev/kustomization",
+		"overlays/prod/patch.yaml",
+		"overlays/qa/kustomization.yaml",
+	}
+	stale := application.StaleFiles(app, files, recorded)
+
+	expectedStale := []string{
+		"deploy/base/service-app2.yaml",
+		"deploy/overlays/dev/kustomization",
+		"deploy/overlays/prod/patch.yaml",
+		"deploy/overlays/qa/kustomization.yaml",
+	}
+	if len(stale) != len(expectedStale) {
+		t.Fatalf("expected stale files %v, got %v", expectedStale, stale)
+	}
+	for i := range stale {
+		if stale[i] != expectedStale[i] {
+			t.Errorf("expected stale files %v, got %v", expectedStale, stale)
+		}
+	}
+
+	diffs, err := application.Diff(files, stale, true, current)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	statuses := map[string]string{}
+	for _, diff := range diffs {
+		statuses[diff.Name] = diff.Status
+	}
+	for filename, status := range map[string]string{
+		"apps/app1.yaml":                  models.FileDiffStatusAdded,
+		"deploy/base/service-app1.yaml":   models.FileDiffStatusModified,
+		"deploy/base/service-app2.yaml":   models.FileDiffStatusDeleted,
+		"deploy/overlays/prod/patch.yaml": models.FileDiffStatusDeleted,
+	} {
+		if statuses[filename] != status {
+			t.Errorf("%s: expected %q, got %q", filename, status, statuses[filename])
+		}
+	}
+	if _, ok := statuses["deploy/base/service-account.yaml"]; ok {
+		t.Error("expected unchanged files to be left out")
+	}
+	if _, ok := statuses["deploy/overlays/qa/kustomization.yaml"]; ok {
+		t.Error("expected recorded files removed by hand to be left out")
+	}
+
+	kept, err := application.Diff(files, stale, false, current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, diff := range kept {
+		if diff.Name == "deploy/base/service-app2.yaml" && (diff.Status != models.FileDiffStatusStale || diff.Diff != "") {
+			t.Errorf("expected a stale file to be kept without pruning, got %+v", diff)
+		}
+	}
+
+	expected := "--- a/deploy/base/service-app1.yaml\n+++ b/deploy/base/service-app1.yaml\n@@ -1 +1,3 @@\n kind: Service\n+metadata:\n+  name: app1\n"
+	for _, diff := range diffs {
+		if diff.Name == "deploy/base/service-app1.yaml" && diff.Diff != expected {
+			t.Errorf("expected diff:\n%s\ngot:\n%s", expected, diff.Diff)
+		}
+		if diff.Name == "apps/app1.yaml" && diff.Diff != "--- /dev/null\n+++ b/apps/app1.yaml\n@@ -0,0 +1 @@\n+kind: Application\n" {
+			t.Errorf("unexpected diff of an added file:\n%s", diff.Diff)
+		}
+	}
+}
+
+func TestLoadFiles(t *testing.T) {
+	record, err := application.RenderFiles([]string{"base/service-app1.yaml", "application.json"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	read := func(filename string) (string, error) {
+		if filename != "deploy/files.json" {
+			return "", os.ErrNotExist
+		}
+		return record, nil
+	}
+
+	files, err := application.LoadFiles("/deploy", read)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 || files[0] != "application.json" || files[1] != "base/service-app1.yaml" {
+		t.Errorf("expected the sorted files, got %v", files)
+	}
+
+	if files, err := application.LoadFiles("/other", read); err != nil || files != nil {
+		t.Errorf("expected no files without a record, got %v (%v)", files, err)
+	}
+}
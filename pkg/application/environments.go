@@ -0,0 +1,62 @@
+package application
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Environment is the server-side configuration of an env
+type Environment struct {
+	Env string `yaml:"env"`
+
+	// DeployTarget is used by the applications of the env that do not select one
+	DeployTarget string `yaml:"deployTarget,omitempty"`
+	// KubernetesVersion is the minor version the clusters of the env run, e.g. "1.22"
+	KubernetesVersion string `yaml:"kubernetesVersion,omitempty"`
+	// PromoteWarnings are the codes of the warnings that prevent the release
+	// of the applications of the env, e.g. "latest-tag"
+	PromoteWarnings []string `yaml:"promoteWarnings,omitempty"`
+}
+
+// Environments holds the server-side configuration of each env
+type Environments []*Environment
+
+// Lookup returns the configuration of the env, or nil if it is not configured
+func (e Environments) Lookup(env string) *Environment {
+	for _, environment := range e {
+		if strings.EqualFold(environment.Env, env) {
+			return environment
+		}
+	}
+	return nil
+}
+
+// Validate checks that every environment names an env, a known deploy
+// target, a supported Kubernetes version and the codes of warnings
+func (e Environments) Validate() error {
+	seen := map[string]struct{}{}
+	for i, environment := range e {
+		if environment.Env == "" {
+			return fmt.Errorf("environment %d: env is required", i)
+		}
+
+		key := strings.ToLower(environment.Env)
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("environment %d: duplicate env %q", i, environment.Env)
+		}
+		seen[key] = struct{}{}
+
+		if _, ok := deployTargets[environment.DeployTarget]; environment.DeployTarget != "" && !ok {
+			return fmt.Errorf("environment %d: unknown deploy target %q", i, environment.DeployTarget)
+		}
+		if environment.KubernetesVersion != "" && !isKubernetesVersion(environment.KubernetesVersion) {
+			return fmt.Errorf("environment %d: unsupported Kubernetes version %q", i, environment.KubernetesVersion)
+		}
+		for _, code := range environment.PromoteWarnings {
+			if !isWarningCode(code) {
+				return fmt.Errorf("environment %d: %q is not the code of a warning", i, code)
+			}
+		}
+	}
+	return nil
+}
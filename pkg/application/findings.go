@@ -0,0 +1,182 @@
+package application
+
+import (
+	"strconv"
+	"strings"
+
+	"deploy-wizard/gen/models"
+)
+
+const (
+	warnMsgLatestTag     = "%q does not pin a version of the image; every restart may pull a different one"
+	warnMsgSingleReplica = "a single replica of component %q is unavailable in %s while it restarts"
+	warnMsgMissingProbe  = "container %q has no %s probe"
+
+	infoMsgMissingResources = "container %q has no resource requests or limits"
+)
+
+// Findings holds what checking an application found by severity, each nested
+// by field like the errors of ValidateApplication. Only errors prevent a
+// release.
+type Findings struct {
+	Errors   map[string]interface{}
+	Warnings map[string]interface{}
+	Infos    map[string]interface{}
+}
+
+// CheckApplication validates an application and reviews it for practices
+// that do not prevent a release: the warnings and informational findings.
+// The warnings PromoteWarnings selects for the env of the application are
+// errors instead.
+func CheckApplication(appdata interface{}, opts ...ValidationOption) *Findings {
+	options := &validationOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	app, errors := validateApplication(appdata, options)
+	findings := &Findings{
+		Errors:   errors,
+		Warnings: map[string]interface{}{},
+		Infos:    map[string]interface{}{},
+	}
+	if app == nil {
+		return findings
+	}
+
+	var env string
+	if app.Metadata.Labels != nil {
+		env = app.Metadata.Labels.Env
+	}
+	reviewSpec(app, env, findings)
+
+	if environment := options.environments.Lookup(env); environment != nil && len(environment.PromoteWarnings) > 0 {
+		promoteWarnings(findings.Errors, findings.Warnings, environment.PromoteWarnings)
+	}
+
+	return findings
+}
+
+// reviewSpec adds the warnings and informational findings of the spec of an
+// application deployed to an env
+func reviewSpec(app *models.Application, env string, findings *Findings) {
+	spec := app.Spec
+	for i, component := range spec.Components {
+		componentPath := []string{"spec", "components", strconv.Itoa(i)}
+
+		for j, container := range component.Containers {
+			containerPath := append(componentPath, "containers", strconv.Itoa(j))
+
+			if container.ImageTag == "latest" {
+				setValidationError(findings.Warnings, newValidationError(warnMsgLatestTag, container.Image+":"+container.ImageTag), append(containerPath, "imageTag")...)
+			}
+			if container.ReadinessProbe == nil {
+				setValidationError(findings.Warnings, newValidationError(warnMsgMissingProbe, container.Name, "readiness"), append(containerPath, "readinessProbe")...)
+			}
+			if container.LivenessProbe == nil {
+				setValidationError(findings.Warnings, newValidationError(warnMsgMissingProbe, container.Name, "liveness"), append(containerPath, "livenessProbe")...)
+			}
+			if container.Resources == nil || (container.Resources.Requests == nil && container.Resources.Limits == nil) {
+				setValidationError(findings.Infos, newValidationError(infoMsgMissingResources, container.Name), append(containerPath, "resources")...)
+			}
+		}
+	}
+
+	if spec.Kustomize != nil {
+		for i, image := range spec.Kustomize.Images {
+			if image.NewTag == "latest" {
+				setValidationError(findings.Warnings, newValidationError(warnMsgLatestTag, image.Name+":"+image.NewTag), "spec", "kustomize", "images", strconv.Itoa(i), "newTag")
+			}
+		}
+	}
+
+	for i, overlay := range spec.Overlays {
+		for j, componentOverlay := range overlay.Components {
+			component := findComponent(app, componentOverlay.Name)
+			for k, containerOverlay := range componentOverlay.Containers {
+				if containerOverlay.ImageTag != "latest" {
+					continue
+				}
+				image := containerOverlay.Name
+				if component != nil {
+					if container := findContainer(component, containerOverlay.Name); container != nil {
+						image = container.Image
+					}
+				}
+				setValidationError(findings.Warnings, newValidationError(warnMsgLatestTag, image+":"+containerOverlay.ImageTag),
+					"spec", "overlays", strconv.Itoa(i), "components", strconv.Itoa(j), "containers", strconv.Itoa(k), "imageTag")
+			}
+		}
+	}
+
+	reviewReplicas(spec, env, findings)
+}
+
+// reviewReplicas warns about the components that run a single replica in
+// Prod, when the application is deployed to Prod or has an overlay for it
+func reviewReplicas(spec *models.Spec, env string, findings *Findings) {
+	var prodOverlay *models.Overlay
+	prodOverlayIndex := -1
+	for i, overlay := range spec.Overlays {
+		if strings.EqualFold(overlay.Env, models.LabelsEnvProd) {
+			prodOverlay, prodOverlayIndex = overlay, i
+			break
+		}
+	}
+	if prodOverlay == nil && !strings.EqualFold(env, models.LabelsEnvProd) {
+		return
+	}
+
+	for i, component := range spec.Components {
+		if component.Service == nil {
+			continue
+		}
+		replicas, replicasPath := component.Replicas, []string{"spec", "components", strconv.Itoa(i), "replicas"}
+		if replicas == 0 {
+			replicas = defaultComponentReplicas
+		}
+		if prodOverlay != nil {
+			for j, componentOverlay := range prodOverlay.Components {
+				if componentOverlay.Name == component.Service.Name && componentOverlay.Replicas > 0 {
+					replicas = componentOverlay.Replicas
+					replicasPath = []string{"spec", "overlays", strconv.Itoa(prodOverlayIndex), "components", strconv.Itoa(j), "replicas"}
+				}
+			}
+		}
+		if replicas == 1 {
+			setValidationError(findings.Warnings, newValidationError(warnMsgSingleReplica, component.Service.Name, models.LabelsEnvProd), replicasPath...)
+		}
+	}
+}
+
+// promoteWarnings moves the warnings with one of the codes to the errors.
+// It returns whether no warnings are left.
+func promoteWarnings(errors, warnings map[string]interface{}, codes []string) bool {
+	for key, value := range warnings {
+		switch value := value.(type) {
+		case map[string]interface{}:
+			nested, ok := errors[key].(map[string]interface{})
+			if !ok && errors[key] != nil {
+				// the field already has an error of its own
+				continue
+			}
+			if !ok {
+				nested = map[string]interface{}{}
+			}
+			if promoteWarnings(nested, value, codes) {
+				delete(warnings, key)
+			}
+			if len(nested) > 0 {
+				errors[key] = nested
+			}
+		case validationError:
+			if containsString(codes, value.code) {
+				if errors[key] == nil {
+					errors[key] = value
+				}
+				delete(warnings, key)
+			}
+		}
+	}
+	return len(warnings) == 0
+}
@@ -0,0 +1,154 @@
+package application_test
+
+import (
+	"strings"
+	"testing"
+
+	"deploy-wizard/gen/models"
+	"deploy-wizard/pkg/application"
+)
+
+// newReviewedApplication returns the valid application with a latest image
+// tag and a component that runs a single replica in Prod
+func newReviewedApplication() *models.Application {
+	app := newDeterminismApplication(models.DestinationFormatKustomize)
+	app.Metadata.Labels.Env = "Prod"
+	app.Spec.Overlays = nil
+	app.Spec.Components[0].Replicas = 3
+	app.Spec.Components[1].Replicas = 2
+	app.Spec.Components[2].Replicas = 1
+	for _, component := range app.Spec.Components {
+		container := component.Containers[0]
+		container.ReadinessProbe = &models.Probe{PortName: "http", Path: "/ready"}
+		container.LivenessProbe = &models.Probe{PortName: "http"}
+		container.Resources = &models.ResourceRequirements{Requests: &models.ResourceList{CPU: "100m"}}
+	}
+	app.Spec.Components[1].Containers[0].ImageTag = "latest"
+	return app
+}
+
+func TestCheckApplication(t *testing.T) {
+	app := newReviewedApplication()
+	app.Spec.Components[0].Containers[0].LivenessProbe = nil
+	app.Spec.Components[0].Containers[0].Resources = nil
+
+	findings := application.CheckApplication(app)
+	if len(findings.Errors) > 0 {
+		t.Fatalf("expected no errors, got %v", findings.Errors)
+	}
+
+	expected := map[string]string{
+		"spec.components.1.containers.0.imageTag":      `"nginx:latest" does not pin a version of the image`,
+		"spec.components.2.replicas":                   `a single replica of component "worker" is unavailable in Prod`,
+		"spec.components.0.containers.0.livenessProbe": `container "web" has no liveness probe`,
+	}
+	for field, message := range expected {
+		if got := validationError(findings.Warnings, field); !strings.HasPrefix(got, message) {
+			t.Errorf("expected the warning %s to be %q, got %v", field, message, findings.Warnings)
+		}
+	}
+	if got := validationError(findings.Infos, "spec.components.0.containers.0.resources"); got != `container "web" has no resource requests or limits` {
+		t.Errorf("expected an informational finding about resources, got %v", findings.Infos)
+	}
+	if issues := application.Issues(findings.Warnings, models.ValidationIssueSeverityWarning); len(issues) != len(expected) {
+		t.Errorf("expected %d warnings, got %s", len(expected), toJSON(t, issues))
+	}
+}
+
+func TestCheckApplicationProdOverlay(t *testing.T) {
+	app := newReviewedApplication()
+	app.Metadata.Labels.Env = "Dev"
+	app.Spec.Components[2].Replicas = 2
+	app.Spec.Overlays = []*models.Overlay{
+		{Env: "Prod", Components: []*models.ComponentOverlay{{Name: "api", Replicas: 1}}},
+	}
+
+	findings := application.CheckApplication(app)
+	if got := validationError(findings.Warnings, "spec.overlays.0.components.0.replicas"); !strings.Contains(got, `"api"`) {
+		t.Errorf("expected a warning for the replicas of the Prod overlay, got %v", findings.Warnings)
+	}
+
+	app.Spec.Overlays = nil
+	app.Spec.Components[2].Replicas = 1
+	if got := validationError(application.CheckApplication(app).Warnings, "spec.components.2.replicas"); got != "" {
+		t.Errorf("expected no replica warnings outside of Prod, got %q", got)
+	}
+}
+
+func TestCheckApplicationOverlayLatestTag(t *testing.T) {
+	app := newReviewedApplication()
+	app.Spec.Components[1].Containers[0].ImageTag = "1.21"
+	app.Spec.Overlays = []*models.Overlay{
+		{Env: "Dev", Components: []*models.ComponentOverlay{{Name: "api", Containers: []*models.ContainerOverlay{{Name: "api", ImageTag: "latest"}}}}},
+	}
+
+	findings := application.CheckApplication(app)
+	if got := validationError(findings.Warnings, "spec.overlays.0.components.0.containers.0.imageTag"); !strings.HasPrefix(got, `"nginx:latest" does not pin`) {
+		t.Errorf("expected a warning for the image tag of the overlay, got %v", findings.Warnings)
+	}
+}
+
+func TestCheckApplicationPromoteWarnings(t *testing.T) {
+	environments := application.Environments{
+		{Env: "Prod", PromoteWarnings: []string{"latest-tag"}},
+		{Env: "Dev", PromoteWarnings: []string{"single-replica"}},
+	}
+	if err := environments.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	findings := application.CheckApplication(newReviewedApplication(), application.PromoteWarnings(environments))
+
+	field := "spec.components.1.containers.0.imageTag"
+	if validationError(findings.Errors, field) == "" || validationError(findings.Warnings, field) != "" {
+		t.Errorf("expected %s to be an error, got errors %v and warnings %v", field, findings.Errors, findings.Warnings)
+	}
+	field = "spec.components.2.replicas"
+	if validationError(findings.Errors, field) != "" || validationError(findings.Warnings, field) == "" {
+		t.Errorf("expected %s to stay a warning, got errors %v and warnings %v", field, findings.Errors, findings.Warnings)
+	}
+	if _, ok := findings.Warnings["spec"].(map[string]interface{})["components"].(map[string]interface{})["1"]; ok {
+		t.Errorf("expected no warnings left for the promoted component, got %v", findings.Warnings)
+	}
+
+	if errs := application.ValidateApplication(newReviewedApplication(), application.PromoteWarnings(environments)); validationError(errs, "spec.components.1.containers.0.imageTag") == "" {
+		t.Errorf("expected ValidateApplication to report the promoted warning, got %v", errs)
+	}
+}
+
+func TestEnvironmentsValidatePromoteWarnings(t *testing.T) {
+	for _, code := range []string{"latest-tag", "single-replica", "missing-probe"} {
+		if err := (application.Environments{{Env: "Prod", PromoteWarnings: []string{code}}}).Validate(); err != nil {
+			t.Errorf("%s: %s", code, err)
+		}
+	}
+	for _, code := range []string{"required", "missing-resources", "latest"} {
+		if err := (application.Environments{{Env: "Prod", PromoteWarnings: []string{code}}}).Validate(); err == nil {
+			t.Errorf("%s: expected an error for a code that is not a warning", code)
+		}
+	}
+}
+
+func TestNewFindingsResponse(t *testing.T) {
+	app := newReviewedApplication()
+	container := app.Spec.Components[1].Containers[0]
+	container.ImageTag = "latest!"
+	container.Resources = nil
+
+	response := application.NewFindingsResponse(application.CheckApplication(app))
+
+	var severities []string
+	for i, issue := range response.Issues {
+		if i > 0 && response.Issues[i-1].Path > issue.Path {
+			t.Errorf("expected the issues ordered by path, got %s before %s", response.Issues[i-1].Path, issue.Path)
+		}
+		severities = append(severities, issue.Severity)
+	}
+	expected := []string{models.ValidationIssueSeverityError, models.ValidationIssueSeverityInfo, models.ValidationIssueSeverityWarning}
+	if strings.Join(severities, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected the severities %v, got %s", expected, toJSON(t, response.Issues))
+	}
+	if len(response.Errors) == 0 || len(response.Warnings) == 0 || len(response.Infos) == 0 {
+		t.Errorf("expected errors, warnings and infos, got %s", toJSON(t, response))
+	}
+}
@@ -0,0 +1,111 @@
+package application
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"deploy-wizard/gen/models"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	fluxTemplates = map[string][]string{
+		models.DestinationFormatKustomize: {"flux-gitrepository.yaml", "flux-kustomization.yaml"},
+		models.DestinationFormatHelm:      {"flux-gitrepository.yaml", "flux-helmrelease.yaml"},
+	}
+
+	regexCommitSHA = regexp.MustCompile(`^[0-9a-f]{40}$`)
+)
+
+// fluxRef is the GitRepository reference a Flux source tracks
+type fluxRef struct {
+	Branch string
+	Commit string
+	Name   string
+}
+
+// newFluxRef converts an ArgoCD style target revision to a GitRepository
+// reference. HEAD tracks the default branch.
+func newFluxRef(targetRevision string) *fluxRef {
+	switch {
+	case targetRevision == "" || targetRevision == defaultApplicationTargetRevision:
+		return nil
+	case regexCommitSHA.MatchString(targetRevision):
+		return &fluxRef{Commit: targetRevision}
+	case strings.HasPrefix(targetRevision, "refs/"):
+		return &fluxRef{Name: targetRevision}
+	default:
+		return &fluxRef{Branch: targetRevision}
+	}
+}
+
+// fluxTarget deploys applications with a Flux GitRepository and Kustomization
+type fluxTarget struct{}
+
+func (fluxTarget) renderDeploySpecs(r *Renderer, app *models.Application, current FileReader) (map[string]string, error) {
+	cluster, err := r.clusters.Lookup(app.Metadata.Labels.Region, app.Metadata.Labels.Env)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeConfigSecret, ok := cluster.FluxKubeConfigSecret()
+	if !ok {
+		return nil, fmt.Errorf(errMsgFluxCluster, cluster.Server)
+	}
+
+	sourcePath := SourcePath(app)
+	if sourcePath == "." {
+		sourcePath = ""
+	}
+
+	syncPolicy := app.Spec.Destination.SyncPolicy
+	data := struct {
+		App              *models.Application
+		Cluster          *Cluster
+		KubeConfigSecret string
+		Ref              *fluxRef
+		Path             string
+		Prune            bool
+		Suspend          bool
+		RetryInterval    string
+		ValuesFiles      []string
+	}{
+		App:              app,
+		Cluster:          cluster,
+		KubeConfigSecret: kubeConfigSecret,
+		Ref:              newFluxRef(app.Spec.Destination.TargetRevision),
+		Path:             "./" + sourcePath,
+		// applications synced manually with ArgoCD are suspended with Flux
+		Suspend: syncPolicy != nil && syncPolicy.Automated == nil,
+		Prune:   syncPolicy != nil && syncPolicy.Automated != nil && syncPolicy.Automated.Prune,
+	}
+	if syncPolicy != nil && syncPolicy.Retry != nil && syncPolicy.Retry.Backoff != nil {
+		data.RetryInterval = syncPolicy.Retry.Backoff.Duration
+	}
+
+	for _, valueFile := range ValueFiles(app) {
+		data.ValuesFiles = append(data.ValuesFiles, "./"+path.Join(sourcePath, valueFile))
+	}
+
+	var results []string
+	for _, tmpl := range fluxTemplates[Format(app)] {
+		t, err := r.lookupTemplate(app, tmpl)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("rendering %q", t.Name())
+		result, err := renderTemplate(t, data)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	return map[string]string{
+		deploySpecFile(app): strings.Join(results, "---\n"),
+	}, nil
+}
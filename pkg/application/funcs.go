@@ -0,0 +1,220 @@
+package application
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+	"unicode/utf8"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// templateFuncs are the functions available to every template. Their names
+// and argument order follow Helm, so template authors can move between both;
+// scalar, literal and commandArgs are the wizard's own.
+var templateFuncs = template.FuncMap{
+	"quote":     quote,
+	"indent":    indent,
+	"nindent":   nindent,
+	"toYaml":    toYaml,
+	"toJson":    toJSON,
+	"default":   defaultValue,
+	"lower":     strings.ToLower,
+	"upper":     strings.ToUpper,
+	"b64enc":    b64enc,
+	"sha256sum": sha256sum,
+	"required":  required,
+
+	"scalar":      scalar,
+	"literal":     literal,
+	"commandArgs": templateCommandArgs,
+}
+
+// TemplateFuncs returns the functions available to every template
+func TemplateFuncs() template.FuncMap {
+	funcs := template.FuncMap{}
+	for name, fn := range templateFuncs {
+		funcs[name] = fn
+	}
+	return funcs
+}
+
+// quote returns its arguments as double quoted strings, separated by spaces.
+// nil arguments are left out.
+func quote(values ...interface{}) string {
+	var quoted []string
+	for _, value := range values {
+		if value != nil {
+			quoted = append(quoted, strconv.Quote(toString(value)))
+		}
+	}
+	return strings.Join(quoted, " ")
+}
+
+// indent prefixes every line of s with spaces
+func indent(spaces int, s string) string {
+	pad := strings.Repeat(" ", spaces)
+	return pad + strings.Replace(s, "\n", "\n"+pad, -1)
+}
+
+// nindent starts s on a new line and prefixes every line with spaces
+func nindent(spaces int, s string) string {
+	return "\n" + indent(spaces, s)
+}
+
+// scalar renders value as a YAML scalar that reads back as the same string:
+// plain when that is unambiguous, quoted otherwise. Empty values render as
+// nothing, so optional fields stay null. Every string that comes from an
+// application is rendered with it, so that no value can add keys or
+// documents to a manifest.
+func scalar(value interface{}) string {
+	s := toString(value)
+	if s == "" {
+		return ""
+	}
+
+	data, err := yaml.Marshal(s)
+	out := strings.TrimSuffix(string(data), "\n")
+	if err != nil || strings.Contains(out, "\n") || strings.HasPrefix(out, "!!") {
+		return strconv.Quote(s)
+	}
+	return out
+}
+
+// literal renders s as a literal block scalar whose lines are indented by
+// spaces, for the value of a key: {{ .Data | literal 4 }}. Like a clipped
+// block, the value reads back with a single trailing line break. A string a
+// block can not hold as it is, such as one with carriage returns or leading
+// spaces, is double quoted instead.
+func literal(spaces int, s string) string {
+	if s = clip(s); s == "" {
+		return `""`
+	}
+	if !blockSafe(s) {
+		return strconv.Quote(s)
+	}
+	return "|" + nindent(spaces, strings.TrimSuffix(s, "\n"))
+}
+
+// clip ends s with a single line break, unless s is empty
+func clip(s string) string {
+	s = strings.TrimRight(s, "\n")
+	if s == "" {
+		return ""
+	}
+	return s + "\n"
+}
+
+// blockSafe reports whether a literal block scalar reads back as s: s holds
+// no line breaks other than \n nor non-printable characters, and does not
+// start with a space or tab that would be taken for indentation
+func blockSafe(s string) bool {
+	if !utf8.ValidString(s) || strings.IndexAny(strings.TrimLeft(s, "\n"), " \t") == 0 {
+		return false
+	}
+	for _, r := range s {
+		if r != '\n' && r != '\t' && !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// templateCommandArgs returns the arguments of the command of a container,
+// for templates to render as a list: {{ commandArgs .Command | toJson }}
+func templateCommandArgs(command interface{}) []string {
+	return commandArgs(toString(command))
+}
+
+// toYaml marshals value to YAML, without the trailing newline
+func toYaml(value interface{}) (string, error) {
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(string(data), "\n"), nil
+}
+
+// toJSON marshals value to JSON
+func toJSON(value interface{}) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// defaultValue returns given, or def when given is empty. It is meant to be
+// piped to: {{ .Value | default "x" }}.
+func defaultValue(def interface{}, given ...interface{}) interface{} {
+	if len(given) == 0 || isEmpty(given[0]) {
+		return def
+	}
+	return given[0]
+}
+
+// b64enc encodes s with standard base64
+func b64enc(s string) string {
+	return base64.StdEncoding.EncodeToString([]byte(s))
+}
+
+// sha256sum returns the hex encoded SHA-256 checksum of s
+func sha256sum(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// required fails the template with msg when value is empty
+func required(msg string, value interface{}) (interface{}, error) {
+	if isEmpty(value) {
+		return nil, errors.New(msg)
+	}
+	return value, nil
+}
+
+func toString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		if rv := reflect.ValueOf(value); rv.Kind() == reflect.Ptr {
+			if rv.IsNil() {
+				return ""
+			}
+			return toString(rv.Elem().Interface())
+		}
+		return fmt.Sprint(v)
+	}
+}
+
+// isEmpty reports whether value is nil or the zero value of its type. Empty
+// slices, maps and strings are empty too.
+func isEmpty(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	case reflect.Struct:
+		return false
+	default:
+		return reflect.DeepEqual(value, reflect.Zero(v.Type()).Interface())
+	}
+}
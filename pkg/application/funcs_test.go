@@ -0,0 +1,150 @@
+package application_test
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+
+	"deploy-wizard/pkg/application"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+func execute(t *testing.T, text string, data interface{}) (string, error) {
+	tmpl, err := template.New("test").Funcs(application.TemplateFuncs()).Parse(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b strings.Builder
+	err = tmpl.Execute(&b, data)
+	return b.String(), err
+}
+
+func testFunc(t *testing.T, tests []struct{ text, expected string }, data interface{}) {
+	for _, test := range tests {
+		result, err := execute(t, test.text, data)
+		if err != nil {
+			t.Errorf("%s: %v", test.text, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("%s: expected %q, got %q", test.text, test.expected, result)
+		}
+	}
+}
+
+func TestQuote(t *testing.T) {
+	testFunc(t, []struct{ text, expected string }{
+		{`{{ quote "a" }}`, `"a"`},
+		{`{{ quote "a\"b" }}`, `"a\"b"`},
+		{`{{ quote 1 true }}`, `"1" "true"`},
+		{`{{ quote .Missing }}`, ``},
+	}, map[string]interface{}{})
+}
+
+func TestScalar(t *testing.T) {
+	testFunc(t, []struct{ text, expected string }{
+		{`{{ "a" | scalar }}`, `a`},
+		{`{{ "" | scalar }}`, ``},
+		{`{{ "1.0" | scalar }}`, `"1.0"`},
+		{`{{ "true" | scalar }}`, `"true"`},
+		{`{{ "a: b" | scalar }}`, `'a: b'`},
+		{`{{ "a\nb: c" | scalar }}`, `"a\nb: c"`},
+		{`{{ "!!binary a" | scalar }}`, `'!!binary a'`},
+	}, nil)
+}
+
+func TestLiteral(t *testing.T) {
+	testFunc(t, []struct{ text, expected string }{
+		{`x: {{ "a: b\n---\nc: d" | literal 2 }}`, "x: |\n  a: b\n  ---\n  c: d"},
+		{`x: {{ "" | literal 2 }}`, `x: ""`},
+		{`x: {{ "  a" | literal 2 }}`, `x: "  a\n"`},
+		{`x: {{ "\ta" | literal 2 }}`, `x: "\ta\n"`},
+		{`x: {{ "a\r\nb" | literal 2 }}`, `x: "a\r\nb\n"`},
+	}, nil)
+}
+
+func TestIndent(t *testing.T) {
+	testFunc(t, []struct{ text, expected string }{
+		{`{{ indent 2 "a" }}`, "  a"},
+		{`{{ indent 2 "a\nb" }}`, "  a\n  b"},
+		{`{{ "a\nb" | indent 4 }}`, "    a\n    b"},
+	}, nil)
+}
+
+func TestNindent(t *testing.T) {
+	testFunc(t, []struct{ text, expected string }{
+		{`{{ nindent 2 "a" }}`, "\n  a"},
+		{`x:{{ "a\nb" | nindent 2 }}`, "x:\n  a\n  b"},
+	}, nil)
+}
+
+func TestToYaml(t *testing.T) {
+	testFunc(t, []struct{ text, expected string }{
+		{`{{ toYaml .List }}`, "- a\n- b"},
+		{`{{ toYaml .Map }}`, "a: 1\nb: x"},
+	}, map[string]interface{}{
+		"List": []string{"a", "b"},
+		"Map":  yaml.MapSlice{{Key: "a", Value: 1}, {Key: "b", Value: "x"}},
+	})
+}
+
+func TestToJson(t *testing.T) {
+	testFunc(t, []struct{ text, expected string }{
+		{`{{ toJson .List }}`, `["a","b"]`},
+		{`{{ toJson .Map }}`, `{"a":1}`},
+	}, map[string]interface{}{
+		"List": []string{"a", "b"},
+		"Map":  map[string]int{"a": 1},
+	})
+}
+
+func TestDefault(t *testing.T) {
+	testFunc(t, []struct{ text, expected string }{
+		{`{{ .Name | default "x" }}`, "app"},
+		{`{{ .Empty | default "x" }}`, "x"},
+		{`{{ .Zero | default 8080 }}`, "8080"},
+		{`{{ .List | default "x" }}`, "x"},
+		{`{{ default "x" }}`, "x"},
+	}, map[string]interface{}{
+		"Name":  "app",
+		"Empty": "",
+		"Zero":  0,
+		"List":  []string{},
+	})
+}
+
+func TestLowerUpper(t *testing.T) {
+	testFunc(t, []struct{ text, expected string }{
+		{`{{ lower "App" }}`, "app"},
+		{`{{ upper "App" }}`, "APP"},
+	}, nil)
+}
+
+func TestB64enc(t *testing.T) {
+	testFunc(t, []struct{ text, expected string }{
+		{`{{ b64enc "secret" }}`, "c2VjcmV0"},
+		{`{{ b64enc "" }}`, ""},
+	}, nil)
+}
+
+func TestSha256sum(t *testing.T) {
+	testFunc(t, []struct{ text, expected string }{
+		{`{{ sha256sum "" }}`, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+		{`{{ "abc" | sha256sum }}`, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+	}, nil)
+}
+
+func TestRequired(t *testing.T) {
+	data := map[string]interface{}{"Name": "app", "Empty": ""}
+
+	testFunc(t, []struct{ text, expected string }{
+		{`{{ required "name is required" .Name }}`, "app"},
+	}, data)
+
+	_, err := execute(t, `{{ required "name is required" .Empty }}`, data)
+	if err == nil || !strings.Contains(err.Error(), "name is required") {
+		t.Errorf("expected a required error, got %v", err)
+	}
+}
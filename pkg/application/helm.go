@@ -0,0 +1,423 @@
+package application
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"deploy-wizard/gen/models"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	chartTemplateDir = "helm"
+	chartFile        = "Chart.yaml"
+	valuesFile       = "values.yaml"
+	defaultFormat    = models.DestinationFormatKustomize
+)
+
+// chartTemplates are the templates of the Helm chart. They are parametrized
+// by the values of the application and copied to the chart as they are.
+var chartTemplates = []string{
+	"_helpers.tpl",
+	"serviceaccount.yaml",
+	"service.yaml",
+	"deployment.yaml",
+	"ingress.yaml",
+	"configmap.yaml",
+	"persistentvolumeclaim.yaml",
+}
+
+// chartValues are the values of the Helm chart of an application. Components,
+// containers and ingresses are keyed by name so that the values of an env
+// only hold what its overlay adjusts: Helm merges maps but replaces lists.
+// APIVersions holds the API version of each kind of kindAPIVersions the
+// configured Kubernetes version serves, so the templates do not depend on the
+// capabilities of the cluster the chart happens to be installed to.
+type chartValues struct {
+	App               *chartApp                  `yaml:"app,omitempty"`
+	APIVersions       map[string]string          `yaml:"apiVersions,omitempty"`
+	Components        map[string]*chartComponent `yaml:"components,omitempty"`
+	ConfigMaps        []*chartConfigMap          `yaml:"configMaps,omitempty"`
+	PersistentVolumes []*chartPersistentVolume   `yaml:"persistentVolumes,omitempty"`
+}
+
+type chartApp struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+}
+
+type chartComponent struct {
+	Replicas   int64                      `yaml:"replicas,omitempty"`
+	Service    *chartService              `yaml:"service,omitempty"`
+	Ingresses  map[string]*chartIngress   `yaml:"ingresses,omitempty"`
+	Containers map[string]*chartContainer `yaml:"containers,omitempty"`
+	Volumes    *chartVolumes              `yaml:"volumes,omitempty"`
+}
+
+type chartService struct {
+	Type  string              `yaml:"type"`
+	Ports []*chartServicePort `yaml:"ports"`
+}
+
+type chartServicePort struct {
+	Name       string `yaml:"name"`
+	Port       int64  `yaml:"port"`
+	Protocol   string `yaml:"protocol"`
+	TargetPort int64  `yaml:"targetPort,omitempty"`
+}
+
+type chartIngress struct {
+	Host  string              `yaml:"host,omitempty"`
+	Paths []*chartIngressPath `yaml:"paths,omitempty"`
+}
+
+type chartIngressPath struct {
+	Path     string `yaml:"path"`
+	PortName string `yaml:"portName"`
+}
+
+type chartContainer struct {
+	Image           string                `yaml:"image,omitempty"`
+	ImageTag        string                `yaml:"imageTag,omitempty"`
+	ImagePullPolicy string                `yaml:"imagePullPolicy,omitempty"`
+	Command         []string              `yaml:"command,omitempty"`
+	Resources       *chartResources       `yaml:"resources,omitempty"`
+	ReadinessProbe  *probe                `yaml:"readinessProbe,omitempty"`
+	LivenessProbe   *probe                `yaml:"livenessProbe,omitempty"`
+	Ports           []*chartContainerPort `yaml:"ports,omitempty"`
+	VolumeMounts    []*chartVolumeMount   `yaml:"volumeMounts,omitempty"`
+}
+
+type chartResources struct {
+	Requests *chartResourceList `yaml:"requests,omitempty"`
+	Limits   *chartResourceList `yaml:"limits,omitempty"`
+}
+
+type chartResourceList struct {
+	CPU    string `yaml:"cpu,omitempty"`
+	Memory string `yaml:"memory,omitempty"`
+}
+
+type chartContainerPort struct {
+	Name          string `yaml:"name"`
+	ContainerPort int64  `yaml:"containerPort"`
+	Protocol      string `yaml:"protocol"`
+}
+
+type chartVolumeMount struct {
+	Name      string `yaml:"name"`
+	MountPath string `yaml:"mountPath"`
+	SubPath   string `yaml:"subPath,omitempty"`
+	ReadOnly  bool   `yaml:"readOnly"`
+}
+
+type chartVolumes struct {
+	ConfigMaps             []string `yaml:"configMaps,omitempty"`
+	PersistentVolumeClaims []string `yaml:"persistentVolumeClaims,omitempty"`
+}
+
+type chartConfigMap struct {
+	Name string `yaml:"name"`
+	Data string `yaml:"data"`
+}
+
+type chartPersistentVolume struct {
+	Name             string `yaml:"name"`
+	AccessMode       string `yaml:"accessMode"`
+	Capacity         int64  `yaml:"capacity"`
+	StorageClassName string `yaml:"storageClassName"`
+}
+
+// Format returns the format the manifests of the application are rendered
+// in. It defaults to a Kustomize base with overlays.
+func Format(app *models.Application) string {
+	if format := app.Spec.Destination.Format; format != "" {
+		return format
+	}
+	return defaultFormat
+}
+
+// ChartPath returns the path of the Helm chart of the application, relative
+// to the repository root
+func ChartPath(app *models.Application) string {
+	if chartPath := strings.Trim(path.Clean(app.Spec.Destination.Path), "/"); chartPath != "" {
+		return chartPath
+	}
+	return "."
+}
+
+// SourcePath returns the path the deploy target syncs the application from,
+// relative to the repository root
+func SourcePath(app *models.Application) string {
+	if Format(app) == models.DestinationFormatHelm {
+		return ChartPath(app)
+	}
+	return OverlayPath(app)
+}
+
+// valuesFileName returns the name of the values file of an env
+func valuesFileName(env string) string {
+	return fmt.Sprintf("values-%s.yaml", strings.ToLower(env))
+}
+
+// ValueFiles returns the values files the chart of the application is
+// installed with, relative to the chart
+func ValueFiles(app *models.Application) []string {
+	if Format(app) != models.DestinationFormatHelm {
+		return nil
+	}
+
+	valueFiles := []string{valuesFile}
+	if findOverlay(app, app.Metadata.Labels.Env) != nil {
+		valueFiles = append(valueFiles, valuesFileName(app.Metadata.Labels.Env))
+	}
+	return valueFiles
+}
+
+// RenderChart renders an application to the files of a Helm chart: the
+// chart, the values of the application, the values of each env it has an
+// overlay for and the chart templates
+func (r *Renderer) RenderChart(app *models.Application) (map[string]string, error) {
+	files := map[string]string{}
+
+	t, err := r.lookupTemplate(app, path.Join(chartTemplateDir, chartFile))
+	if err != nil {
+		return files, err
+	}
+
+	log.Infof("rendering %q", t.Name())
+	chart, err := renderTemplate(t, app)
+	if err != nil {
+		return files, err
+	}
+	files[chartFile] = chart
+
+	baseValues := newChartValues(app)
+	baseValues.APIVersions = r.APIVersions(app)
+	values, err := yaml.Marshal(baseValues)
+	if err != nil {
+		return files, errors.Wrap(err, "failed to marshal chart values")
+	}
+	files[valuesFile] = string(values)
+
+	for _, overlay := range app.Spec.Overlays {
+		overlayValues, err := newOverlayChartValues(app, overlay)
+		if err != nil {
+			return files, err
+		}
+
+		values, err := yaml.Marshal(overlayValues)
+		if err != nil {
+			return files, errors.Wrapf(err, "failed to marshal chart values of env %q", overlay.Env)
+		}
+		files[valuesFileName(overlay.Env)] = string(values)
+	}
+
+	for _, tmpl := range chartTemplates {
+		content, err := r.templateContent(app, chartTemplatePath(tmpl))
+		if err != nil {
+			return files, err
+		}
+		files[path.Join("templates", tmpl)] = content
+	}
+
+	return files, nil
+}
+
+// renderChartPreview joins the files of the Helm chart, each preceded by its
+// name
+func (r *Renderer) renderChartPreview(app *models.Application) (string, error) {
+	files, err := r.RenderChart(app)
+	if err != nil {
+		return "", err
+	}
+
+	var filenames []string
+	for filename := range files {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	var results []string
+	for _, filename := range filenames {
+		results = append(results, fmt.Sprintf("# Source: %s\n%s", filename, files[filename]))
+	}
+
+	return strings.Join(results, "\n---\n"), nil
+}
+
+// newChartValues returns the values of the Helm chart of an application
+func newChartValues(app *models.Application) *chartValues {
+	values := &chartValues{
+		App: &chartApp{
+			Name:    app.Metadata.Name,
+			Version: app.Metadata.Labels.Version,
+		},
+		Components: map[string]*chartComponent{},
+	}
+
+	for _, component := range app.Spec.Components {
+		values.Components[component.Service.Name] = newChartComponent(component)
+	}
+
+	for _, configMap := range app.Spec.ConfigMaps {
+		values.ConfigMaps = append(values.ConfigMaps, &chartConfigMap{Name: configMap.Name, Data: clip(configMap.Data)})
+	}
+
+	for _, persistentVolume := range app.Spec.PersistentVolumes {
+		values.PersistentVolumes = append(values.PersistentVolumes, &chartPersistentVolume{
+			Name:             persistentVolume.Name,
+			AccessMode:       persistentVolume.AccessMode,
+			Capacity:         persistentVolume.Capacity,
+			StorageClassName: persistentVolume.StorageClassName,
+		})
+	}
+
+	return values
+}
+
+func newChartComponent(component *models.Component) *chartComponent {
+	service := component.Service
+	chartComponent := &chartComponent{
+		Replicas:   component.Replicas,
+		Service:    &chartService{Type: service.Type},
+		Ingresses:  map[string]*chartIngress{},
+		Containers: map[string]*chartContainer{},
+	}
+
+	for _, port := range service.Ports {
+		chartComponent.Service.Ports = append(chartComponent.Service.Ports, &chartServicePort{
+			Name:       port.Name,
+			Port:       port.Port,
+			Protocol:   port.Protocol,
+			TargetPort: port.TargetPort,
+		})
+	}
+
+	for _, ingress := range component.Ingresses {
+		chartIngress := &chartIngress{Host: ingress.Host}
+		for _, ingressPath := range ingress.Paths {
+			chartIngress.Paths = append(chartIngress.Paths, &chartIngressPath{Path: ingressPath.Path, PortName: ingressPath.PortName})
+		}
+		chartComponent.Ingresses[ingress.Host] = chartIngress
+	}
+
+	cms := map[string]struct{}{}
+	pvs := map[string]struct{}{}
+	for _, container := range component.Containers {
+		chartContainer := &chartContainer{
+			Image:           container.Image,
+			ImageTag:        container.ImageTag,
+			ImagePullPolicy: container.ImagePullPolicy,
+			Resources:       newChartResources(container.Resources),
+			ReadinessProbe:  newProbe(container.ReadinessProbe),
+			LivenessProbe:   newProbe(container.LivenessProbe),
+		}
+		if container.Command != nil && *container.Command != "" {
+			chartContainer.Command = commandArgs(*container.Command)
+		}
+
+		for _, portName := range container.PortNames {
+			for _, port := range service.Ports {
+				if port.Name != portName {
+					continue
+				}
+				containerPort := port.Port
+				if port.TargetPort != 0 {
+					containerPort = port.TargetPort
+				}
+				chartContainer.Ports = append(chartContainer.Ports, &chartContainerPort{
+					Name:          port.Name,
+					ContainerPort: containerPort,
+					Protocol:      port.Protocol,
+				})
+			}
+		}
+
+		for _, vol := range container.Volumes {
+			mount := &chartVolumeMount{Name: vol.Name, MountPath: vol.MountPath, ReadOnly: vol.ReadOnly}
+			if vol.SubPath != nil {
+				mount.SubPath = *vol.SubPath
+			}
+			chartContainer.VolumeMounts = append(chartContainer.VolumeMounts, mount)
+
+			switch vol.Type {
+			case models.VolumeMountTypeConfigMap:
+				cms[vol.Name] = struct{}{}
+			case models.VolumeMountTypePersistentVolume:
+				pvs[vol.Name] = struct{}{}
+			}
+		}
+
+		chartComponent.Containers[container.Name] = chartContainer
+	}
+
+	if len(cms) > 0 || len(pvs) > 0 {
+		chartComponent.Volumes = &chartVolumes{ConfigMaps: mapKeys(cms), PersistentVolumeClaims: mapKeys(pvs)}
+		sort.Strings(chartComponent.Volumes.ConfigMaps)
+		sort.Strings(chartComponent.Volumes.PersistentVolumeClaims)
+	}
+
+	return chartComponent
+}
+
+func newChartResources(resources *models.ResourceRequirements) *chartResources {
+	if resources == nil {
+		return nil
+	}
+
+	chartResources := &chartResources{}
+	if resources.Requests != nil {
+		chartResources.Requests = &chartResourceList{CPU: resources.Requests.CPU, Memory: resources.Requests.Memory}
+	}
+	if resources.Limits != nil {
+		chartResources.Limits = &chartResourceList{CPU: resources.Limits.CPU, Memory: resources.Limits.Memory}
+	}
+	return chartResources
+}
+
+// newOverlayChartValues returns the values an overlay adjusts for its env
+func newOverlayChartValues(app *models.Application, overlay *models.Overlay) (*chartValues, error) {
+	values := &chartValues{Components: map[string]*chartComponent{}}
+
+	for _, componentOverlay := range overlay.Components {
+		component := findComponent(app, componentOverlay.Name)
+		if component == nil {
+			return nil, fmt.Errorf("overlay %q: no component with service %q", overlay.Env, componentOverlay.Name)
+		}
+
+		chartComponent := &chartComponent{Replicas: componentOverlay.Replicas}
+
+		for _, containerOverlay := range componentOverlay.Containers {
+			if findContainer(component, containerOverlay.Name) == nil {
+				return nil, fmt.Errorf("overlay %q: no container %q in component %q", overlay.Env, containerOverlay.Name, componentOverlay.Name)
+			}
+			if chartComponent.Containers == nil {
+				chartComponent.Containers = map[string]*chartContainer{}
+			}
+			chartComponent.Containers[containerOverlay.Name] = &chartContainer{
+				ImageTag:  containerOverlay.ImageTag,
+				Resources: newChartResources(containerOverlay.Resources),
+			}
+		}
+
+		for _, ingressOverlay := range componentOverlay.Ingresses {
+			if findIngress(component, ingressOverlay.Host) == nil {
+				return nil, fmt.Errorf("overlay %q: no ingress for host %q in component %q", overlay.Env, ingressOverlay.Host, componentOverlay.Name)
+			}
+			if chartComponent.Ingresses == nil {
+				chartComponent.Ingresses = map[string]*chartIngress{}
+			}
+			chartComponent.Ingresses[ingressOverlay.Host] = &chartIngress{Host: ingressOverlay.NewHost}
+		}
+
+		values.Components[componentOverlay.Name] = chartComponent
+	}
+
+	return values, nil
+}
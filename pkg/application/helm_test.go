@@ -0,0 +1,126 @@
+package application_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"deploy-wizard/gen/models"
+	"deploy-wizard/pkg/application"
+
+	"github.com/andreyvit/diff"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestRenderChart(t *testing.T) {
+	app := application.ApplyDefaults(validApplication)
+	app.Spec.Destination.Format = models.DestinationFormatHelm
+	app.Spec.Overlays = []*models.Overlay{prodOverlay}
+	defer func() {
+		app.Spec.Destination.Format = ""
+		app.Spec.Overlays = nil
+	}()
+
+	renderer, err := application.NewRenderer("../../_templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := renderer.RenderManifests(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, filename := range []string{"Chart.yaml", "values.yaml", "values-prod.yaml"} {
+		golden := filepath.Join("testdata", "chart", filename)
+		if *update {
+			if err := ioutil.WriteFile(golden, []byte(results[filename]), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		expected, err := ioutil.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result := results[filename]; result != string(expected) {
+			t.Errorf("%s not as expected:\n%v", filename, diff.LineDiff(result, string(expected)))
+		}
+	}
+
+	for _, filename := range []string{"templates/_helpers.tpl", "templates/deployment.yaml", "templates/ingress.yaml"} {
+		if _, ok := results[filename]; !ok {
+			t.Errorf("%s not found", filename)
+		}
+	}
+	if _, ok := results["base/kustomization.yaml"]; ok {
+		t.Error("expected no kustomization in the helm format")
+	}
+}
+
+func TestRenderChartAPIVersions(t *testing.T) {
+	app := application.ApplyDefaults(validApplication)
+	app.Spec.Destination.Format = models.DestinationFormatHelm
+	defer func() { app.Spec.Destination.Format = "" }()
+
+	renderer, err := application.NewRenderer("../../_templates", application.WithEnvironments(application.Environments{{Env: "Dev", KubernetesVersion: "1.25"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := renderer.RenderManifests(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{"CronJob: batch/v1\n", "PodDisruptionBudget: policy/v1\n"} {
+		if !strings.Contains(results["values.yaml"], expected) {
+			t.Errorf("expected the values of Kubernetes 1.25 to hold %q, got:\n%s", expected, results["values.yaml"])
+		}
+	}
+	if strings.Contains(results["templates/ingress.yaml"], "Capabilities") {
+		t.Errorf("expected the ingress template to take its API version from the values, got:\n%s", results["templates/ingress.yaml"])
+	}
+}
+
+func TestRenderDeploySpecHelm(t *testing.T) {
+	app := application.ApplyDefaults(validApplication)
+	app.Spec.Destination.Format = models.DestinationFormatHelm
+	app.Spec.Destination.Path = "/deploy/"
+	app.Spec.Overlays = []*models.Overlay{{Env: "Dev"}}
+	defer func() {
+		app.Spec.Destination.Format = ""
+		app.Spec.Destination.Path = "/"
+		app.Spec.Overlays = nil
+	}()
+
+	renderer, err := application.NewRenderer("../../_templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := renderer.RenderDeploySpec(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "    path: deploy\n    helm:\n      valueFiles:\n      - values.yaml\n      - values-dev.yaml\n"; !strings.Contains(result, want) {
+		t.Errorf("expected %q in deploy spec:\n%s", want, result)
+	}
+
+	app.Spec.Destination.DeployTarget = models.DestinationDeployTargetFlux
+	defer func() { app.Spec.Destination.DeployTarget = "" }()
+
+	results, err := renderer.RenderDeploySpecs(app, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "      chart: ./deploy\n"; !strings.Contains(results["apps/app1.yaml"], want) {
+		t.Errorf("expected %q in deploy spec:\n%s", want, results["apps/app1.yaml"])
+	}
+	if want := "      valuesFiles:\n      - ./deploy/values.yaml\n      - ./deploy/values-dev.yaml\n"; !strings.Contains(results["apps/app1.yaml"], want) {
+		t.Errorf("expected %q in deploy spec:\n%s", want, results["apps/app1.yaml"])
+	}
+}
@@ -0,0 +1,924 @@
+package application
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"deploy-wizard/gen/models"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// kustomizationFiles are the names kustomize looks up in a directory
+var kustomizationFiles = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
+
+// serverFields are the fields the API server sets on the resources it
+// returns, which are not part of a manifest
+var serverFields = [][]string{
+	{"status"},
+	{"metadata", "creationTimestamp"},
+	{"metadata", "generation"},
+	{"metadata", "managedFields"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "selfLink"},
+	{"metadata", "uid"},
+	{"metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration"},
+	{"metadata", "annotations", "deployment.kubernetes.io/revision"},
+}
+
+// importResource is a resource decoded from a manifest. The importer removes
+// the fields it maps from doc, so that what remains are the unmapped fields.
+type importResource struct {
+	kind     string
+	name     string
+	doc      map[interface{}]interface{}
+	original map[interface{}]interface{}
+}
+
+func (r *importResource) ref() string {
+	return r.kind + "/" + r.name
+}
+
+// importer reconstructs an application from the resources of manifests
+type importer struct {
+	app       *models.Application
+	resources []*importResource
+	unmapped  []*models.UnmappedField
+}
+
+// ImportManifests reconstructs an application from multi-document YAML with
+// its Deployments, Services, Ingresses, ConfigMaps and PersistentVolumeClaims.
+// The response lists the fields of the manifests the application has no
+// counterpart for.
+func ImportManifests(manifests string) (*models.ImportResponse, error) {
+	i := newImporter()
+	if err := i.addManifest("manifests", manifests); err != nil {
+		return nil, err
+	}
+	return i.build()
+}
+
+// ImportKustomization reconstructs an application from the resources of the
+// kustomization in a directory, like ImportManifests. Its namespace, name
+// prefix and suffix, common labels and annotations, images and generators
+// are imported into the kustomize features of the application.
+func ImportKustomization(dir string, read FileReader) (*models.ImportResponse, error) {
+	i := newImporter()
+	if err := i.addKustomization(strings.TrimPrefix(path.Clean("/"+dir), "/"), read, 0); err != nil {
+		return nil, err
+	}
+	return i.build()
+}
+
+func newImporter() *importer {
+	return &importer{
+		app: &models.Application{
+			Metadata: &models.Metadata{Labels: &models.Labels{}},
+			Spec:     &models.Spec{Destination: &models.Destination{}},
+		},
+	}
+}
+
+func (i *importer) addManifest(filename, content string) error {
+	decoder := yaml.NewDecoder(strings.NewReader(content))
+	for {
+		var doc map[interface{}]interface{}
+		err := decoder.Decode(&doc)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode %s", filename)
+		}
+		i.addResource(doc)
+	}
+}
+
+func (i *importer) addResource(doc map[interface{}]interface{}) {
+	if doc == nil {
+		return
+	}
+	if lookupString(doc, "kind") == "List" {
+		for _, item := range lookupList(doc, "items") {
+			if item, ok := item.(map[interface{}]interface{}); ok {
+				i.addResource(item)
+			}
+		}
+		return
+	}
+
+	for _, field := range serverFields {
+		take(doc, field...)
+	}
+	resource := &importResource{
+		kind: takeString(doc, "kind"),
+		name: takeString(doc, "metadata", "name"),
+		doc:  doc,
+	}
+	take(doc, "apiVersion")
+	resource.original = copyValue(doc).(map[interface{}]interface{})
+	i.resources = append(i.resources, resource)
+}
+
+// addKustomization adds the resources of a kustomization and imports its
+// features. Resources that are directories are kustomizations themselves.
+func (i *importer) addKustomization(dir string, read FileReader, depth int) error {
+	if depth > 10 {
+		return fmt.Errorf("the kustomization %q nests too deep", dir)
+	}
+
+	var content string
+	var err error
+	for _, name := range kustomizationFiles {
+		if content, err = read(path.Join(dir, name)); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return errors.Wrapf(err, "no kustomization found in %q", dir)
+	}
+
+	var doc map[interface{}]interface{}
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return errors.Wrapf(err, "failed to decode the kustomization of %q", dir)
+	}
+	if doc == nil {
+		doc = map[interface{}]interface{}{}
+	}
+	take(doc, "apiVersion")
+	take(doc, "kind")
+	kustomization := &importResource{kind: "Kustomization", name: dir, doc: doc, original: copyValue(doc).(map[interface{}]interface{})}
+
+	for _, item := range lookupList(doc, "resources") {
+		resource, ok := item.(string)
+		if !ok || strings.Contains(resource, "://") {
+			// remote resources are not followed
+			continue
+		}
+		filename := path.Join(dir, resource)
+		if content, err := read(filename); err == nil {
+			if err := i.addManifest(filename, content); err != nil {
+				return err
+			}
+		} else if err := i.addKustomization(filename, read, depth+1); err != nil {
+			return errors.Wrapf(err, "failed to read the resource %q", filename)
+		}
+		removeItem(doc, "resources", item)
+	}
+
+	i.importKustomize(doc)
+	i.report(kustomization)
+	return nil
+}
+
+// importKustomize imports the kustomize features of a kustomization
+func (i *importer) importKustomize(doc map[interface{}]interface{}) {
+	if namespace := takeString(doc, "namespace"); namespace != "" {
+		i.app.Metadata.Namespace = namespace
+	}
+
+	kustomize := i.app.Spec.Kustomize
+	if kustomize == nil {
+		kustomize = &models.Kustomize{}
+	}
+	kustomize.NamePrefix = takeString(doc, "namePrefix") + kustomize.NamePrefix
+	kustomize.NameSuffix += takeString(doc, "nameSuffix")
+	kustomize.CommonLabels = mergeStringMaps(kustomize.CommonLabels, takeStringMap(doc, "commonLabels"))
+	kustomize.CommonAnnotations = mergeStringMaps(kustomize.CommonAnnotations, takeStringMap(doc, "commonAnnotations"))
+
+	for _, item := range lookupList(doc, "images") {
+		image, ok := item.(map[interface{}]interface{})
+		if !ok {
+			continue
+		}
+		kustomize.Images = append(kustomize.Images, &models.KustomizeImage{
+			Name:    takeString(image, "name"),
+			NewName: takeString(image, "newName"),
+			NewTag:  takeString(image, "newTag"),
+			Digest:  takeString(image, "digest"),
+		})
+	}
+	for _, item := range lookupList(doc, "configMapGenerator") {
+		if generator, ok := item.(map[interface{}]interface{}); ok {
+			kustomize.ConfigMapGenerator = append(kustomize.ConfigMapGenerator, &models.ConfigMapGenerator{
+				Name:     takeString(generator, "name"),
+				Behavior: takeString(generator, "behavior"),
+				Literals: takeStrings(generator, "literals"),
+				Files:    takeStrings(generator, "files"),
+				Envs:     takeStrings(generator, "envs"),
+			})
+		}
+	}
+	for _, item := range lookupList(doc, "secretGenerator") {
+		if generator, ok := item.(map[interface{}]interface{}); ok {
+			kustomize.SecretGenerator = append(kustomize.SecretGenerator, &models.SecretGenerator{
+				Name:     takeString(generator, "name"),
+				Type:     takeString(generator, "type"),
+				Behavior: takeString(generator, "behavior"),
+				Literals: takeStrings(generator, "literals"),
+				Files:    takeStrings(generator, "files"),
+				Envs:     takeStrings(generator, "envs"),
+			})
+		}
+	}
+
+	if !reflect.DeepEqual(kustomize, &models.Kustomize{}) {
+		i.app.Spec.Kustomize = kustomize
+	}
+}
+
+// build maps the resources to the application. ConfigMaps, claims and
+// Services come first, as Deployments reference them and Ingresses reference
+// the components of Deployments.
+func (i *importer) build() (*models.ImportResponse, error) {
+	for _, resource := range i.byKind("ConfigMap") {
+		i.importConfigMap(resource)
+	}
+	for _, resource := range i.byKind("PersistentVolumeClaim") {
+		i.importPersistentVolumeClaim(resource)
+	}
+	services := i.byKind("Service")
+	for _, resource := range i.byKind("Deployment") {
+		i.importDeployment(resource, services)
+	}
+	if len(i.app.Spec.Components) == 0 {
+		return nil, errors.New("no Deployment found to import")
+	}
+	for _, resource := range i.byKind("Ingress") {
+		i.importIngress(resource)
+	}
+	for _, resource := range i.byKind("ServiceAccount") {
+		// the service account of the application is rendered for it
+		if resource.name == i.app.Metadata.Name {
+			i.takeCommonMetadata(resource)
+		}
+	}
+
+	for _, resource := range i.resources {
+		i.report(resource)
+	}
+	sort.SliceStable(i.unmapped, func(a, b int) bool {
+		if i.unmapped[a].Resource != i.unmapped[b].Resource {
+			return i.unmapped[a].Resource < i.unmapped[b].Resource
+		}
+		return i.unmapped[a].Field < i.unmapped[b].Field
+	})
+
+	log.Infof("imported %d components with %d unmapped fields", len(i.app.Spec.Components), len(i.unmapped))
+	return &models.ImportResponse{Application: i.app, Unmapped: i.unmapped}, nil
+}
+
+func (i *importer) byKind(kind string) []*importResource {
+	var resources []*importResource
+	for _, resource := range i.resources {
+		if resource.kind == kind {
+			resources = append(resources, resource)
+		}
+	}
+	return resources
+}
+
+// takeCommonMetadata imports the namespace and the labels the templates
+// render on every resource: app, component and release
+func (i *importer) takeCommonMetadata(resource *importResource) {
+	doc := resource.doc
+	if namespace := lookupString(doc, "metadata", "namespace"); namespace != "" {
+		if i.app.Metadata.Namespace == "" {
+			i.app.Metadata.Namespace = namespace
+		}
+		if namespace == i.app.Metadata.Namespace {
+			take(doc, "metadata", "namespace")
+		}
+	}
+	if name := lookupString(doc, "metadata", "labels", "app"); name != "" {
+		if i.app.Metadata.Name == "" {
+			i.app.Metadata.Name = name
+		}
+		if name == i.app.Metadata.Name {
+			take(doc, "metadata", "labels", "app")
+		}
+	}
+	if version := lookupString(doc, "metadata", "labels", "release"); version != "" {
+		if i.app.Metadata.Labels.Version == "" {
+			i.app.Metadata.Labels.Version = version
+		}
+		if version == i.app.Metadata.Labels.Version {
+			take(doc, "metadata", "labels", "release")
+		}
+	}
+	if lookupString(doc, "metadata", "labels", "component") == resource.name {
+		take(doc, "metadata", "labels", "component")
+	}
+}
+
+func (i *importer) importConfigMap(resource *importResource) {
+	i.takeCommonMetadata(resource)
+	// the data is rendered as a literal block, which ends it with a newline
+	i.app.Spec.ConfigMaps = append(i.app.Spec.ConfigMaps, &models.ConfigMap{
+		Name: resource.name,
+		Data: strings.TrimSuffix(takeString(resource.doc, "data", "data"), "\n"),
+	})
+}
+
+func (i *importer) importPersistentVolumeClaim(resource *importResource) {
+	doc := resource.doc
+	i.takeCommonMetadata(resource)
+
+	persistentVolume := &models.PersistentVolume{
+		Name:             resource.name,
+		StorageClassName: takeString(doc, "spec", "storageClassName"),
+	}
+	if accessModes := lookupList(doc, "spec", "accessModes"); len(accessModes) == 1 {
+		persistentVolume.AccessMode = takeString(doc, "spec", "accessModes", "0")
+	}
+	if storage := lookupString(doc, "spec", "resources", "requests", "storage"); strings.HasSuffix(storage, "Gi") {
+		if capacity, err := strconv.ParseInt(strings.TrimSuffix(storage, "Gi"), 10, 64); err == nil {
+			persistentVolume.Capacity = capacity
+			take(doc, "spec", "resources", "requests", "storage")
+		}
+	}
+	i.app.Spec.PersistentVolumes = append(i.app.Spec.PersistentVolumes, persistentVolume)
+}
+
+// importDeployment imports a Deployment and the Service that selects its
+// pods as a component
+func (i *importer) importDeployment(resource *importResource, services []*importResource) {
+	doc := resource.doc
+	i.takeCommonMetadata(resource)
+
+	component := &models.Component{Replicas: takeInt(doc, "spec", "replicas")}
+	podLabels := lookupStringMap(doc, "spec", "template", "metadata", "labels")
+	if selector := lookupStringMap(doc, "spec", "selector", "matchLabels"); isSubset(selector, podLabels) {
+		take(doc, "spec", "selector", "matchLabels")
+	}
+	if lookupString(doc, "spec", "strategy", "type") == "Recreate" {
+		// the strategy the templates render
+		take(doc, "spec", "strategy", "type")
+	}
+	for key, value := range podLabels {
+		if value == lookupString(resource.original, "metadata", "labels", key) {
+			take(doc, "spec", "template", "metadata", "labels", key)
+		}
+	}
+	if isRenderedAffinity(lookupMap(doc, "spec", "template", "spec", "affinity")) {
+		take(doc, "spec", "template", "spec", "affinity")
+	}
+
+	for _, service := range services {
+		if selector := lookupStringMap(service.doc, "spec", "selector"); len(selector) > 0 && isSubset(selector, podLabels) {
+			component.Service = i.importService(service)
+			break
+		}
+	}
+	if component.Service == nil {
+		component.Service = &models.Service{Name: resource.name}
+	}
+
+	volumes := map[string]*models.VolumeMount{}
+	for _, item := range lookupList(doc, "spec", "template", "spec", "volumes") {
+		volume, ok := item.(map[interface{}]interface{})
+		if !ok {
+			continue
+		}
+		switch name := lookupString(volume, "name"); {
+		case lookupString(volume, "configMap", "name") != "":
+			volumes[name] = &models.VolumeMount{Name: lookupString(volume, "configMap", "name"), Type: models.VolumeMountTypeConfigMap}
+		case lookupString(volume, "persistentVolumeClaim", "claimName") != "":
+			volumes[name] = &models.VolumeMount{Name: lookupString(volume, "persistentVolumeClaim", "claimName"), Type: models.VolumeMountTypePersistentVolume}
+		case lookupString(volume, "secret", "secretName") != "":
+			volumes[name] = &models.VolumeMount{Name: lookupString(volume, "secret", "secretName"), Type: models.VolumeMountTypeSecret}
+		}
+	}
+
+	for _, item := range lookupList(doc, "spec", "template", "spec", "containers") {
+		if c, ok := item.(map[interface{}]interface{}); ok {
+			component.Containers = append(component.Containers, importContainer(c, component.Service, volumes))
+		}
+	}
+
+	for _, item := range lookupList(doc, "spec", "template", "spec", "volumes") {
+		volume, ok := item.(map[interface{}]interface{})
+		if !ok || volumes[lookupString(volume, "name")] == nil || !isMounted(component, volumes[lookupString(volume, "name")]) {
+			continue
+		}
+		for _, field := range [][]string{{"name"}, {"configMap", "name"}, {"persistentVolumeClaim", "claimName"}, {"secret", "secretName"}} {
+			take(volume, field...)
+		}
+	}
+
+	i.app.Spec.Components = append(i.app.Spec.Components, component)
+}
+
+func (i *importer) importService(resource *importResource) *models.Service {
+	doc := resource.doc
+	i.takeCommonMetadata(resource)
+	for key := range lookupStringMap(doc, "spec", "selector") {
+		take(doc, "spec", "selector", key)
+	}
+
+	service := &models.Service{Name: resource.name, Type: takeString(doc, "spec", "type")}
+	for _, item := range lookupList(doc, "spec", "ports") {
+		port, ok := item.(map[interface{}]interface{})
+		if !ok || lookupString(port, "name") == "" {
+			continue
+		}
+		servicePort := &models.ServicePort{
+			Name:     takeString(port, "name"),
+			Port:     takeInt(port, "port"),
+			Protocol: takeString(port, "protocol"),
+		}
+		if _, ok := port["targetPort"].(int); ok {
+			servicePort.TargetPort = takeInt(port, "targetPort")
+		}
+		service.Ports = append(service.Ports, servicePort)
+	}
+	return service
+}
+
+// importContainer imports a container. Its ports are referenced by the name
+// of the Service port they are exposed on, and its volume mounts by the name
+// of their ConfigMap, claim or Secret.
+func importContainer(c map[interface{}]interface{}, service *models.Service, volumes map[string]*models.VolumeMount) *models.Container {
+	container := &models.Container{
+		Name:            takeString(c, "name"),
+		ImagePullPolicy: takeString(c, "imagePullPolicy"),
+	}
+
+	if image, tag, ok := splitImage(lookupString(c, "image")); ok {
+		take(c, "image")
+		container.Image, container.ImageTag = image, tag
+	}
+
+	if command := lookupStrings(c, "command"); len(command) > 0 {
+		args := append(command, lookupStrings(c, "args")...)
+		quoted := make([]string, len(args))
+		for i, arg := range args {
+			quoted[i] = strconv.Quote(arg)
+		}
+		commandString := strings.Join(quoted, ", ")
+		container.Command = &commandString
+		take(c, "command")
+		take(c, "args")
+	}
+
+	for _, item := range lookupList(c, "ports") {
+		port, ok := item.(map[interface{}]interface{})
+		if !ok {
+			continue
+		}
+		if name := exposingPort(service, lookupInt(port, "containerPort")); name != "" {
+			container.PortNames = append(container.PortNames, name)
+			for _, field := range []string{"name", "containerPort", "protocol"} {
+				take(port, field)
+			}
+		}
+	}
+
+	for _, item := range lookupList(c, "volumeMounts") {
+		mount, ok := item.(map[interface{}]interface{})
+		if !ok || volumes[lookupString(mount, "name")] == nil {
+			continue
+		}
+		volumeMount := *volumes[takeString(mount, "name")]
+		volumeMount.MountPath = takeString(mount, "mountPath")
+		volumeMount.ReadOnly = takeBool(mount, "readOnly")
+		if subPath := takeString(mount, "subPath"); subPath != "" {
+			volumeMount.SubPath = &subPath
+		}
+		container.Volumes = append(container.Volumes, &volumeMount)
+	}
+
+	if resources := lookupMap(c, "resources"); resources != nil {
+		container.Resources = &models.ResourceRequirements{
+			Requests: takeResourceList(c, "resources", "requests"),
+			Limits:   takeResourceList(c, "resources", "limits"),
+		}
+	}
+
+	container.ReadinessProbe = takeProbe(c, service, "readinessProbe")
+	container.LivenessProbe = takeProbe(c, service, "livenessProbe")
+
+	return container
+}
+
+// takeProbe imports an HTTP GET or TCP probe of a container. Its port is
+// referenced by the name of the Service port it is exposed on; probes of
+// other ports or kinds are left unmapped.
+func takeProbe(c map[interface{}]interface{}, service *models.Service, key string) *models.Probe {
+	action := "tcpSocket"
+	if lookupMap(c, key, "httpGet") != nil {
+		action = "httpGet"
+	}
+
+	var portName string
+	switch port := lookup(c, key, action, "port").(type) {
+	case string:
+		portName = port
+	case int:
+		portName = exposingPort(service, int64(port))
+	}
+	if portName == "" {
+		return nil
+	}
+
+	probe := &models.Probe{
+		PortName:            portName,
+		Path:                takeString(c, key, action, "path"),
+		InitialDelaySeconds: takeInt(c, key, "initialDelaySeconds"),
+		PeriodSeconds:       takeInt(c, key, "periodSeconds"),
+	}
+	take(c, key, action, "port")
+	return probe
+}
+
+// exposingPort returns the name of the Service port that exposes a container
+// port, or "" if none does
+func exposingPort(service *models.Service, containerPort int64) string {
+	for _, port := range service.Ports {
+		targetPort := port.TargetPort
+		if targetPort == 0 {
+			targetPort = port.Port
+		}
+		if targetPort == containerPort {
+			return port.Name
+		}
+	}
+	return ""
+}
+
+func isMounted(component *models.Component, volume *models.VolumeMount) bool {
+	for _, container := range component.Containers {
+		for _, mount := range container.Volumes {
+			if mount.Name == volume.Name && mount.Type == volume.Type {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isRenderedAffinity reports whether an affinity is the one the templates
+// render: spreading the pods of a component across nodes
+func isRenderedAffinity(affinity map[interface{}]interface{}) bool {
+	if len(affinity) != 1 {
+		return false
+	}
+	terms := lookupList(affinity, "podAntiAffinity", "preferredDuringSchedulingIgnoredDuringExecution")
+	if len(terms) != 1 || len(lookupMap(affinity, "podAntiAffinity")) != 1 {
+		return false
+	}
+	term, ok := terms[0].(map[interface{}]interface{})
+	return ok && lookupInt(term, "weight") == 100 &&
+		lookupString(term, "podAffinityTerm", "topologyKey") == "kubernetes.io/hostname"
+}
+
+// importIngress imports the paths of an Ingress into the ingresses of the
+// components whose Service they route to
+func (i *importer) importIngress(resource *importResource) {
+	doc := resource.doc
+	i.takeCommonMetadata(resource)
+	if lookupString(doc, "metadata", "annotations", "kubernetes.io/ingress.class") == "nginx" {
+		// the ingress class the templates render
+		take(doc, "metadata", "annotations", "kubernetes.io/ingress.class")
+	}
+
+	for _, item := range lookupList(doc, "spec", "rules") {
+		rule, ok := item.(map[interface{}]interface{})
+		if !ok {
+			continue
+		}
+		host := lookupString(rule, "host")
+		for _, item := range lookupList(rule, "http", "paths") {
+			p, ok := item.(map[interface{}]interface{})
+			if !ok {
+				continue
+			}
+
+			serviceName := lookupString(p, "backend", "service", "name")
+			portName := lookupString(p, "backend", "service", "port", "name")
+			portNumber := lookupInt(p, "backend", "service", "port", "number")
+			if serviceName == "" {
+				// extensions/v1beta1 and networking.k8s.io/v1beta1
+				serviceName = lookupString(p, "backend", "serviceName")
+				portName, _ = lookup(p, "backend", "servicePort").(string)
+				portNumber = lookupInt(p, "backend", "servicePort")
+			}
+
+			component := findComponent(i.app, serviceName)
+			if component == nil {
+				continue
+			}
+			if portName == "" {
+				portName = servicePortName(component.Service, portNumber)
+			}
+			if portName == "" {
+				continue
+			}
+
+			ingress := findIngress(component, host)
+			if ingress == nil {
+				ingress = &models.Ingress{Host: host}
+				component.Ingresses = append(component.Ingresses, ingress)
+			}
+			ingress.Paths = append(ingress.Paths, &models.IngressPath{Path: takeString(p, "path"), PortName: portName})
+
+			take(p, "backend")
+			if pathType := lookupString(p, "pathType"); pathType == "ImplementationSpecific" || pathType == "Prefix" {
+				take(p, "pathType")
+			}
+			take(rule, "host")
+		}
+	}
+}
+
+func servicePortName(service *models.Service, port int64) string {
+	for _, servicePort := range service.Ports {
+		if servicePort.Port == port {
+			return servicePort.Name
+		}
+	}
+	return ""
+}
+
+// unimportedMessages explain why a resource of a supported kind was not
+// imported at all
+var unimportedMessages = map[string]string{
+	"Service":        "no Deployment has the pods the Service selects",
+	"Ingress":        "no path of the Ingress routes to an imported Service",
+	"ServiceAccount": "only the service account named after the application is rendered",
+}
+
+// report adds the fields left in a resource to the unmapped fields. A field
+// whose value was left untouched is reported as a whole.
+func (i *importer) report(resource *importResource) {
+	if reflect.DeepEqual(resource.doc, resource.original) && resource.kind != "Kustomization" {
+		message, ok := unimportedMessages[resource.kind]
+		if !ok {
+			message = fmt.Sprintf("%s resources can not be imported", resource.kind)
+		}
+		i.unmapped = append(i.unmapped, &models.UnmappedField{Resource: resource.ref(), Message: message})
+		return
+	}
+	for _, field := range unmappedFields(resource.doc, resource.original, "") {
+		i.unmapped = append(i.unmapped, &models.UnmappedField{
+			Resource: resource.ref(),
+			Field:    field,
+			Message:  "the application has no counterpart for the field",
+		})
+	}
+}
+
+func unmappedFields(value, original interface{}, prefix string) []string {
+	if reflect.DeepEqual(value, original) && !isPruned(value) && prefix != "" {
+		return []string{prefix}
+	}
+
+	var fields []string
+	switch value := value.(type) {
+	case map[interface{}]interface{}:
+		originalMap, _ := original.(map[interface{}]interface{})
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, fmt.Sprint(key))
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			field := key
+			if prefix != "" {
+				field = prefix + "." + key
+			}
+			fields = append(fields, unmappedFields(value[key], originalMap[key], field)...)
+		}
+	case []interface{}:
+		originalList, _ := original.([]interface{})
+		for index, item := range value {
+			var originalItem interface{}
+			if index < len(originalList) {
+				originalItem = originalList[index]
+			}
+			fields = append(fields, unmappedFields(item, originalItem, fmt.Sprintf("%s[%d]", prefix, index))...)
+		}
+	default:
+		if value != nil {
+			fields = append(fields, prefix)
+		}
+	}
+	return fields
+}
+
+// isPruned reports whether every value in a decoded document is null, as it
+// is once the importer took all of them
+func isPruned(value interface{}) bool {
+	switch value := value.(type) {
+	case map[interface{}]interface{}:
+		for _, v := range value {
+			if !isPruned(v) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		for _, v := range value {
+			if !isPruned(v) {
+				return false
+			}
+		}
+		return true
+	}
+	return value == nil
+}
+
+// splitImage splits an image reference into its name and tag. An image
+// without a tag is the latest one. A reference pinned to a digest has no tag
+// to map it to, so it is not split and is left unmapped.
+func splitImage(image string) (string, string, bool) {
+	if strings.Contains(image, "@") {
+		return "", "", false
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[:i], image[i+1:], true
+	}
+	return image, "latest", true
+}
+
+func takeResourceList(c map[interface{}]interface{}, keys ...string) *models.ResourceList {
+	if lookupMap(c, keys...) == nil {
+		return nil
+	}
+	return &models.ResourceList{
+		CPU:    takeString(c, append(keys, "cpu")...),
+		Memory: takeString(c, append(keys, "memory")...),
+	}
+}
+
+func isSubset(subset, set map[string]string) bool {
+	for key, value := range subset {
+		if set[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func mergeStringMaps(base, overlay map[string]string) map[string]string {
+	if len(overlay) == 0 {
+		return base
+	}
+	merged := map[string]string{}
+	for key, value := range base {
+		merged[key] = value
+	}
+	for key, value := range overlay {
+		merged[key] = value
+	}
+	return merged
+}
+
+// lookup returns the value at the path of keys in a decoded document. A key
+// of a sequence is the index of an item.
+func lookup(value interface{}, keys ...string) interface{} {
+	for _, key := range keys {
+		switch v := value.(type) {
+		case map[interface{}]interface{}:
+			value = v[key]
+		case []interface{}:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil
+			}
+			value = v[index]
+		default:
+			return nil
+		}
+	}
+	return value
+}
+
+// take returns the value at the path of keys like lookup and removes it from
+// the document. An item of a sequence is replaced with null.
+func take(doc map[interface{}]interface{}, keys ...string) interface{} {
+	value := lookup(doc, keys...)
+	switch parent := lookup(doc, keys[:len(keys)-1]...).(type) {
+	case map[interface{}]interface{}:
+		delete(parent, keys[len(keys)-1])
+	case []interface{}:
+		if index, err := strconv.Atoi(keys[len(keys)-1]); err == nil && index >= 0 && index < len(parent) {
+			parent[index] = nil
+		}
+	}
+	return value
+}
+
+func removeItem(doc map[interface{}]interface{}, key string, item interface{}) {
+	items := lookupList(doc, key)
+	for index := range items {
+		if items[index] == item {
+			items[index] = nil
+			return
+		}
+	}
+}
+
+func lookupString(doc interface{}, keys ...string) string {
+	switch value := lookup(doc, keys...).(type) {
+	case string:
+		return value
+	case int, float64, bool:
+		return fmt.Sprint(value)
+	}
+	return ""
+}
+
+func takeString(doc map[interface{}]interface{}, keys ...string) string {
+	value := lookupString(doc, keys...)
+	if value != "" {
+		take(doc, keys...)
+	}
+	return value
+}
+
+func lookupInt(doc interface{}, keys ...string) int64 {
+	if value, ok := lookup(doc, keys...).(int); ok {
+		return int64(value)
+	}
+	return 0
+}
+
+func takeInt(doc map[interface{}]interface{}, keys ...string) int64 {
+	value := lookupInt(doc, keys...)
+	if value != 0 {
+		take(doc, keys...)
+	}
+	return value
+}
+
+func takeBool(doc map[interface{}]interface{}, keys ...string) bool {
+	value, ok := lookup(doc, keys...).(bool)
+	if ok {
+		take(doc, keys...)
+	}
+	return value
+}
+
+func lookupMap(doc interface{}, keys ...string) map[interface{}]interface{} {
+	value, _ := lookup(doc, keys...).(map[interface{}]interface{})
+	return value
+}
+
+func lookupList(doc interface{}, keys ...string) []interface{} {
+	value, _ := lookup(doc, keys...).([]interface{})
+	return value
+}
+
+func lookupStrings(doc interface{}, keys ...string) []string {
+	var values []string
+	for index := range lookupList(doc, keys...) {
+		values = append(values, lookupString(doc, append(keys, strconv.Itoa(index))...))
+	}
+	return values
+}
+
+func takeStrings(doc map[interface{}]interface{}, keys ...string) []string {
+	values := lookupStrings(doc, keys...)
+	take(doc, keys...)
+	return values
+}
+
+func lookupStringMap(doc interface{}, keys ...string) map[string]string {
+	values := map[string]string{}
+	for key := range lookupMap(doc, keys...) {
+		values[fmt.Sprint(key)] = lookupString(doc, append(keys, fmt.Sprint(key))...)
+	}
+	return values
+}
+
+func takeStringMap(doc map[interface{}]interface{}, keys ...string) map[string]string {
+	values := lookupStringMap(doc, keys...)
+	take(doc, keys...)
+	return values
+}
+
+func copyValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[interface{}]interface{}, len(value))
+		for k, v := range value {
+			m[k] = copyValue(v)
+		}
+		return m
+	case []interface{}:
+		items := make([]interface{}, len(value))
+		for i, v := range value {
+			items[i] = copyValue(v)
+		}
+		return items
+	}
+	return value
+}
@@ -0,0 +1,310 @@
+package application_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"deploy-wizard/gen/models"
+	"deploy-wizard/pkg/application"
+)
+
+// joinManifests joins the manifests under dir into multi-document YAML
+func joinManifests(manifests map[string]string, dir string) string {
+	var filenames []string
+	for filename := range manifests {
+		if path.Dir(filename) == dir && path.Base(filename) != "kustomization.yaml" {
+			filenames = append(filenames, filename)
+		}
+	}
+	sort.Strings(filenames)
+
+	var docs []string
+	for _, filename := range filenames {
+		docs = append(docs, manifests[filename])
+	}
+	return strings.Join(docs, "---\n")
+}
+
+func readManifests(manifests map[string]string) application.FileReader {
+	return func(filename string) (string, error) {
+		content, ok := manifests[filename]
+		if !ok {
+			return "", os.ErrNotExist
+		}
+		return content, nil
+	}
+}
+
+func formatUnmapped(unmapped []*models.UnmappedField) []string {
+	var fields []string
+	for _, field := range unmapped {
+		fields = append(fields, fmt.Sprintf("%s %s", field.Resource, field.Field))
+	}
+	return fields
+}
+
+func indexOfComponent(app *models.Application, serviceName string) int {
+	for i, component := range app.Spec.Components {
+		if component.Service.Name == serviceName {
+			return i
+		}
+	}
+	return -1
+}
+
+func toJSON(t *testing.T, value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestImportManifests(t *testing.T) {
+	app := newTypedApplication()
+	manifests := renderManifests(t, app)
+
+	imported, err := application.ImportManifests(joinManifests(manifests, "base"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(imported.Unmapped) > 0 {
+		t.Errorf("expected every field to be imported, got %v", formatUnmapped(imported.Unmapped))
+	}
+
+	got := imported.Application
+	if got.Metadata.Name != app.Metadata.Name || got.Metadata.Labels.Version != app.Metadata.Labels.Version {
+		t.Errorf("expected the name %q and version %q, got %+v", app.Metadata.Name, app.Metadata.Labels.Version, got.Metadata)
+	}
+	for name, field := range map[string][2]interface{}{
+		"components":        {app.Spec.Components, got.Spec.Components},
+		"configMaps":        {app.Spec.ConfigMaps, got.Spec.ConfigMaps},
+		"persistentVolumes": {app.Spec.PersistentVolumes, got.Spec.PersistentVolumes},
+	} {
+		if !reflect.DeepEqual(field[0], field[1]) {
+			t.Errorf("%s: expected %s, got %s", name, toJSON(t, field[0]), toJSON(t, field[1]))
+		}
+	}
+}
+
+func TestImportKustomization(t *testing.T) {
+	app := newDeterminismApplication(models.DestinationFormatKustomize)
+	app.Spec.Kustomize.NamePrefix = "team-"
+	manifests := renderManifests(t, app)
+
+	imported, err := application.ImportKustomization("/base", readManifests(manifests))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(imported.Unmapped) > 0 {
+		t.Errorf("expected every field to be imported, got %v", formatUnmapped(imported.Unmapped))
+	}
+
+	got := imported.Application
+	// the components are imported in the order of their files
+	sort.Slice(got.Spec.Components, func(i, j int) bool {
+		return indexOfComponent(app, got.Spec.Components[i].Service.Name) < indexOfComponent(app, got.Spec.Components[j].Service.Name)
+	})
+	if got.Metadata.Namespace != "tenant1" {
+		t.Errorf("expected the namespace of the kustomization, got %q", got.Metadata.Namespace)
+	}
+	if !reflect.DeepEqual(got.Spec.Kustomize, app.Spec.Kustomize) {
+		t.Errorf("expected the kustomize features %s, got %s", toJSON(t, app.Spec.Kustomize), toJSON(t, got.Spec.Kustomize))
+	}
+	if !reflect.DeepEqual(got.Spec.Components, app.Spec.Components) {
+		t.Errorf("expected the components %s, got %s", toJSON(t, app.Spec.Components), toJSON(t, got.Spec.Components))
+	}
+
+	// an overlay imports its base and reports its patches
+	imported, err = application.ImportKustomization("overlays/prod", readManifests(manifests))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(imported.Application.Spec.Components) != len(app.Spec.Components) {
+		t.Errorf("expected the components of the base, got %s", toJSON(t, imported.Application.Spec.Components))
+	}
+	if unmapped := formatUnmapped(imported.Unmapped); !reflect.DeepEqual(unmapped, []string{"Kustomization/overlays/prod patches"}) {
+		t.Errorf("expected the patches of the overlay to be unmapped, got %v", unmapped)
+	}
+}
+
+func TestImportManifestsUnmapped(t *testing.T) {
+	manifests := `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: shop
+  labels:
+    app: shop
+    tier: frontend
+spec:
+  replicas: 3
+  selector:
+    matchLabels:
+      app: shop
+  strategy:
+    type: RollingUpdate
+  template:
+    metadata:
+      labels:
+        app: shop
+    spec:
+      volumes:
+      - name: tmp
+        emptyDir: {}
+      containers:
+      - name: web
+        image: registry.mc.int:5000/shop/web
+        args: ["--verbose"]
+        env:
+        - name: MODE
+          value: production
+        - name: DEBUG
+          value: "false"
+        ports:
+        - name: http
+          containerPort: 8080
+        - name: admin
+          containerPort: 9090
+        volumeMounts:
+        - name: tmp
+          mountPath: /tmp
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+  namespace: shop
+spec:
+  clusterIP: 10.0.0.1
+  selector:
+    app: shop
+  ports:
+  - name: http
+    port: 80
+    targetPort: 8080
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: legacy
+spec:
+  selector:
+    app: legacy
+  ports:
+  - name: http
+    port: 80
+---
+apiVersion: extensions/v1beta1
+kind: Ingress
+metadata:
+  name: web
+spec:
+  tls:
+  - hosts: [shop.mc.int]
+  rules:
+  - host: shop.mc.int
+    http:
+      paths:
+      - path: /
+        backend:
+          serviceName: web
+          servicePort: 80
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: credentials
+data:
+  password: c2VjcmV0
+`
+
+	imported, err := application.ImportManifests(manifests)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"Deployment/web metadata.labels.tier",
+		"Deployment/web spec.strategy",
+		"Deployment/web spec.template.spec.containers[0].args",
+		"Deployment/web spec.template.spec.containers[0].env",
+		"Deployment/web spec.template.spec.containers[0].ports[1]",
+		"Deployment/web spec.template.spec.containers[0].volumeMounts",
+		"Deployment/web spec.template.spec.volumes",
+		"Ingress/web spec.tls",
+		"Secret/credentials ",
+		"Service/legacy ",
+		"Service/web spec.clusterIP",
+	}
+	if unmapped := formatUnmapped(imported.Unmapped); !reflect.DeepEqual(unmapped, expected) {
+		t.Errorf("expected the unmapped fields\n%s\ngot\n%s", strings.Join(expected, "\n"), strings.Join(unmapped, "\n"))
+	}
+
+	app := imported.Application
+	if app.Metadata.Name != "shop" || app.Metadata.Namespace != "shop" {
+		t.Errorf("expected the application shop in namespace shop, got %+v", app.Metadata)
+	}
+	component := app.Spec.Components[0]
+	if component.Replicas != 3 || component.Service.Name != "web" {
+		t.Errorf("expected the component web with 3 replicas, got %s", toJSON(t, component))
+	}
+	container := component.Containers[0]
+	if container.Image != "registry.mc.int:5000/shop/web" || container.ImageTag != "latest" || !reflect.DeepEqual(container.PortNames, []string{"http"}) {
+		t.Errorf("expected the latest web image exposed on http, got %s", toJSON(t, container))
+	}
+	if ingresses := component.Ingresses; len(ingresses) != 1 || ingresses[0].Host != "shop.mc.int" || ingresses[0].Paths[0].PortName != "http" {
+		t.Errorf("expected the ingress shop.mc.int routing to http, got %s", toJSON(t, ingresses))
+	}
+}
+
+func TestImportManifestsImageDigest(t *testing.T) {
+	manifests := `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  template:
+    spec:
+      containers:
+      - name: web
+        image: registry.mc.int:5000/shop/web@sha256:4bf5c2b1a9d8e7f6a5b4c3d2e1f0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2
+`
+	imported, err := application.ImportManifests(manifests)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	container := imported.Application.Spec.Components[0].Containers[0]
+	if container.Image != "" || container.ImageTag != "" {
+		t.Errorf("expected an image pinned to a digest not to be split, got %s:%s", container.Image, container.ImageTag)
+	}
+	found := false
+	for _, field := range formatUnmapped(imported.Unmapped) {
+		found = found || field == "Deployment/web spec.template.spec.containers[0].image"
+	}
+	if !found {
+		t.Errorf("expected the image pinned to a digest to be unmapped, got %v", formatUnmapped(imported.Unmapped))
+	}
+}
+
+func TestImportManifestsErrors(t *testing.T) {
+	for name, manifests := range map[string]string{
+		"invalid":       "kind: Deployment\n  name: [",
+		"no deployment": "apiVersion: v1\nkind: Service\nmetadata:\n  name: web\n",
+	} {
+		if _, err := application.ImportManifests(manifests); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	if _, err := application.ImportKustomization("base", readManifests(map[string]string{})); err == nil {
+		t.Error("expected an error for a directory without a kustomization")
+	}
+}
@@ -0,0 +1,296 @@
+package application_test
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"deploy-wizard/gen/models"
+	"deploy-wizard/pkg/application"
+
+	"github.com/go-openapi/strfmt"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// injectionPayloads are strings that change the structure of a YAML document
+// when interpolated into it unescaped
+var injectionPayloads = []string{
+	"x\ninjected: true",
+	"x\n---\napiVersion: v1\nkind: Secret",
+	"x\n  injected: true",
+	"x\r\ninjected: true",
+	"x injected: true",
+	"x\u0085injected: true",
+	"key: value",
+	"x # comment",
+	"# comment",
+	"]",
+	"[a, b]",
+	"{a: b}",
+	`"`,
+	"'",
+	"&anchor x",
+	"*alias",
+	"!!binary x",
+	"|",
+	">",
+	"- item",
+	"? x",
+	"@x",
+	"`x`",
+	"%x",
+	"  leading",
+	"\tx",
+	"null",
+	"true",
+	"1e3",
+	"~",
+}
+
+// injectionFields set a user-supplied string of an application
+var injectionFields = map[string]func(app *models.Application, value string){
+	"metadata.name":      func(app *models.Application, value string) { app.Metadata.Name = value },
+	"metadata.namespace": func(app *models.Application, value string) { app.Metadata.Namespace = value },
+	"labels.version":     func(app *models.Application, value string) { app.Metadata.Labels.Version = value },
+	"labels.team":        func(app *models.Application, value string) { app.Metadata.Labels.Team = value },
+	"labels.region":      func(app *models.Application, value string) { app.Metadata.Labels.Region = value },
+	"destination.url": func(app *models.Application, value string) {
+		app.Spec.Destination.URL = strfmt.URI(value)
+	},
+	"destination.path":           func(app *models.Application, value string) { app.Spec.Destination.Path = "/" + value },
+	"destination.targetRevision": func(app *models.Application, value string) { app.Spec.Destination.TargetRevision = value },
+	"service.name":               func(app *models.Application, value string) { injectionComponent(app).Service.Name = value },
+	"port.name": func(app *models.Application, value string) {
+		// the port is referenced by name, rename it everywhere
+		component := injectionComponent(app)
+		component.Service.Ports[0].Name = value
+		component.Containers[0].PortNames = []string{value}
+		component.Ingresses[0].Paths[0].PortName = value
+	},
+	"container.name":  func(app *models.Application, value string) { injectionComponent(app).Containers[0].Name = value },
+	"container.image": func(app *models.Application, value string) { injectionComponent(app).Containers[0].Image = value },
+	"container.imageTag": func(app *models.Application, value string) {
+		injectionComponent(app).Containers[0].ImageTag = value
+	},
+	"container.command": func(app *models.Application, value string) {
+		injectionComponent(app).Containers[0].Command = &value
+	},
+	"volumeMount.mountPath": func(app *models.Application, value string) {
+		injectionComponent(app).Containers[0].Volumes[0].MountPath = "/config/" + value
+	},
+	"volumeMount.subPath": func(app *models.Application, value string) {
+		injectionComponent(app).Containers[0].Volumes[0].SubPath = &value
+	},
+	"ingress.host": func(app *models.Application, value string) { injectionComponent(app).Ingresses[0].Host = value },
+	"ingress.path": func(app *models.Application, value string) {
+		injectionComponent(app).Ingresses[0].Paths[0].Path = "/" + value
+	},
+	"configMap.name":        func(app *models.Application, value string) { app.Spec.ConfigMaps[0].Name = value },
+	"configMap.data":        func(app *models.Application, value string) { app.Spec.ConfigMaps[0].Data = value },
+	"persistentVolume.name": func(app *models.Application, value string) { app.Spec.PersistentVolumes[0].Name = value },
+	"persistentVolume.storageClass": func(app *models.Application, value string) {
+		app.Spec.PersistentVolumes[0].StorageClassName = value
+	},
+	"kustomize.namePrefix": func(app *models.Application, value string) {
+		if app.Spec.Kustomize != nil {
+			app.Spec.Kustomize.NamePrefix = value
+		}
+	},
+	"kustomize.commonLabels": func(app *models.Application, value string) {
+		if app.Spec.Kustomize != nil {
+			app.Spec.Kustomize.CommonLabels["tier"] = value
+		}
+	},
+	"kustomize.images.newTag": func(app *models.Application, value string) {
+		if app.Spec.Kustomize != nil {
+			app.Spec.Kustomize.Images[0].NewTag = value
+		}
+	},
+	"kustomize.configMapGenerator.literals": func(app *models.Application, value string) {
+		if app.Spec.Kustomize != nil {
+			app.Spec.Kustomize.ConfigMapGenerator[0].Literals = []string{"KEY=" + value}
+		}
+	},
+}
+
+// injectionComponent returns the component the injection tests set the
+// strings of. No overlay references it, so renaming it renders.
+func injectionComponent(app *models.Application) *models.Component {
+	return app.Spec.Components[1]
+}
+
+// newInjectionApplication returns an application that renders every template
+// a user-supplied string reaches
+func newInjectionApplication(format string) *models.Application {
+	app := newDeterminismApplication(format)
+
+	app.Spec.Destination.TargetRevision = "main"
+
+	command := `"nginx", "-g", "daemon off;"`
+	subPath := "app.yaml"
+	container := injectionComponent(app).Containers[0]
+	container.Command = &command
+	container.Volumes = append([]*models.VolumeMount{
+		{MountPath: "/config/app.yaml", Name: "alpha", SubPath: &subPath, Type: models.VolumeMountTypeConfigMap},
+	}, container.Volumes[1:]...)
+
+	if app.Spec.Kustomize != nil {
+		app.Spec.Kustomize.NamePrefix = "team-"
+		app.Spec.Kustomize.Images = []*models.KustomizeImage{{Name: "nginx", NewTag: "1.21"}}
+		app.Spec.Kustomize.ConfigMapGenerator = []*models.ConfigMapGenerator{{Name: "settings", Literals: []string{"KEY=value"}}}
+	}
+	return app
+}
+
+// bundleShape returns the shape of every document of a release: its kind and
+// the paths of its values with their type, sequence indexes left out. A
+// string that breaks out of its value changes the shape of the release.
+func bundleShape(t *testing.T, files map[string]string) []string {
+	var shapes []string
+	for filename, content := range files {
+		if strings.Contains("/"+filename, "/templates/") {
+			// chart templates are only YAML once Helm renders them
+			continue
+		}
+
+		decoder := yaml.NewDecoder(strings.NewReader(content))
+		for {
+			var doc interface{}
+			err := decoder.Decode(&doc)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Errorf("%s is not valid YAML: %s\n%s", filename, err, content)
+				break
+			}
+
+			var paths []string
+			collectPaths(doc, "", &paths)
+			sort.Strings(paths)
+			kind := ""
+			if m, ok := doc.(map[interface{}]interface{}); ok {
+				kind = fmt.Sprint(m["kind"])
+			}
+			shapes = append(shapes, kind+" "+strings.Join(dedupe(paths), " "))
+		}
+	}
+	sort.Strings(shapes)
+	return shapes
+}
+
+// namedKeys are the keys of the chart values whose mappings are keyed by
+// user-supplied names
+var namedKeys = map[string]bool{"components": true, "containers": true, "ingresses": true}
+
+func collectPaths(value interface{}, prefix string, paths *[]string) {
+	switch value := value.(type) {
+	case map[interface{}]interface{}:
+		for k, v := range value {
+			key := fmt.Sprint(k)
+			if namedKeys[prefix[strings.LastIndex(prefix, ".")+1:]] {
+				key = "*"
+			}
+			collectPaths(v, prefix+"."+key, paths)
+		}
+	case []interface{}:
+		for _, v := range value {
+			collectPaths(v, prefix+"[]", paths)
+		}
+	default:
+		*paths = append(*paths, fmt.Sprintf("%s:%T", prefix, value))
+	}
+}
+
+func dedupe(values []string) []string {
+	var unique []string
+	for i, value := range values {
+		if i == 0 || values[i-1] != value {
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
+
+func TestRenderBundleInjection(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		opts   []application.RendererOption
+	}{
+		{"kustomize", models.DestinationFormatKustomize, nil},
+		{"typed", models.DestinationFormatKustomize, []application.RendererOption{application.WithRenderMode(application.RenderModeTyped)}},
+		{"flux", models.DestinationFormatKustomize, []application.RendererOption{application.WithEnvironments(application.Environments{{Env: "Dev", DeployTarget: "flux"}})}},
+		{"helm", models.DestinationFormatHelm, nil},
+	}
+
+	for _, test := range tests {
+		renderer, err := application.NewRenderer("", test.opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		files, err := renderer.RenderBundle(newInjectionApplication(test.format), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := bundleShape(t, files)
+
+		for field, set := range injectionFields {
+			for _, payload := range injectionPayloads {
+				app := newInjectionApplication(test.format)
+				set(app, payload)
+
+				files, err := renderer.RenderBundle(app, nil)
+				if err != nil {
+					// rejecting the input is as good as escaping it
+					continue
+				}
+				if got := bundleShape(t, files); !reflect.DeepEqual(got, expected) {
+					t.Errorf("%s: %s set to %q changes the rendered objects:\nexpected: %v\ngot:      %v", test.name, field, payload, expected, got)
+				}
+			}
+		}
+	}
+}
+
+func TestValidateApplicationInjection(t *testing.T) {
+	// names and references must match their Kubernetes format
+	names := []string{
+		"metadata.name", "metadata.namespace", "labels.version", "labels.team", "labels.region",
+		"service.name", "port.name", "container.name", "container.image", "container.imageTag",
+		"ingress.host", "configMap.name", "persistentVolume.name", "kustomize.images.newTag",
+	}
+	// free-form strings only reject whitespace where it is not allowed, line
+	// breaks and control characters
+	freeForm := []string{
+		"destination.url", "destination.path", "destination.targetRevision", "container.command",
+		"volumeMount.mountPath", "volumeMount.subPath", "ingress.path", "persistentVolume.storageClass",
+		"kustomize.namePrefix", "kustomize.commonLabels", "kustomize.configMapGenerator.literals",
+	}
+
+	if verrs := application.ValidateApplication(newInjectionApplication(models.DestinationFormatKustomize)); len(verrs) > 0 {
+		t.Fatalf("expected no errors, got %v", verrs)
+	}
+
+	for _, payload := range injectionPayloads {
+		var fields []string
+		if strings.ContainsAny(payload, "\n\r\u0085\u2028") {
+			fields = append(fields, freeForm...)
+		}
+		if strings.Trim(payload, "abcdefghijklmnopqrstuvwxyz0123456789") != "" {
+			// plain words like null or 1e3 are valid names
+			fields = append(fields, names...)
+		}
+		for _, field := range fields {
+			app := newInjectionApplication(models.DestinationFormatKustomize)
+			injectionFields[field](app, payload)
+			if verrs := application.ValidateApplication(app); len(verrs) == 0 {
+				t.Errorf("expected an error for %s set to %q", field, payload)
+			}
+		}
+	}
+}
@@ -0,0 +1,262 @@
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"deploy-wizard/gen/models"
+)
+
+// issueCodeInvalid is the code of a message without a catalog entry, like
+// the problems the Kubernetes schemas find in the rendered manifests
+const issueCodeInvalid = "invalid"
+
+// issueFormat is the catalog entry of a validation message: the stable code
+// of its kind of issue, the names of the values its format is built from and
+// whether it is a warning an env can promote to an error
+type issueFormat struct {
+	code    string
+	format  string
+	params  []string
+	warning bool
+}
+
+// issueCatalog holds the format of every validation message. An error built
+// with newValidationError gets the code and parameter names of the entry of
+// its format, so a new message format needs an entry here.
+var issueCatalog = indexIssueCatalog([]*issueFormat{
+	{code: "invalid-json", format: errMsgInvalidJSON},
+	{code: "not-an-application", format: errMsgNotAnApplication},
+	{code: "required", format: errMsgRequired, params: []string{"field"}},
+	{code: "dns-label", format: errMsgDNSLabel, params: []string{"value"}},
+	{code: "team-project", format: errMsgTeamProject, params: []string{"value"}},
+	{code: "dns1035-label", format: errMsgDNS1035Label, params: []string{"value"}},
+	{code: "iana-svc-name", format: errMsgIANASvcName, params: []string{"value"}},
+	{code: "label-value", format: errMsgLabelValue, params: []string{"value"}},
+	{code: "image", format: errMsgImage, params: []string{"value"}},
+	{code: "image-tag", format: errMsgImageTag, params: []string{"value"}},
+	{code: "control-characters", format: errMsgControlChars, params: []string{"value"}},
+	{code: "ingress-path", format: errMsgIngressPath, params: []string{"value"}},
+	{code: "relative-sub-path", format: errMsgRelativeSubPath, params: []string{"value"}},
+	{code: "one-of", format: errMsgOneOf, params: []string{"value", "allowed"}},
+	{code: "positive", format: errMsgPositive, params: []string{"field"}},
+	{code: "negative", format: errMsgNegative, params: []string{"field"}},
+	{code: "empty", format: errMsgEmpty, params: []string{"field"}},
+	{code: "name", format: errMsgName, params: []string{"value"}},
+	{code: "namespace", format: errMsgNamespace, params: []string{"value"}},
+	{code: "host-name", format: errMsgHostName, params: []string{"value"}},
+	{code: "quantity", format: errMsgQuantity, params: []string{"value"}},
+	{code: "duration", format: errMsgDuration, params: []string{"value"}},
+	{code: "port-number", format: errMsgPortNumber, params: []string{"value"}},
+	{code: "deploy-target", format: errMsgDeployTarget, params: []string{"value"}},
+	{code: "sync-option", format: errMsgSyncOption, params: []string{"value"}},
+	{code: "literal", format: errMsgLiteral, params: []string{"value"}},
+	{code: "json-pointer", format: errMsgJSONPointer, params: []string{"value"}},
+	{code: "image-override", format: errMsgImageOverride, params: []string{"value"}},
+	{code: "kustomize-helm", format: errMsgKustomizeHelm},
+	{code: "generator-source", format: errMsgGeneratorSource},
+	{code: "generator-files", format: errMsgGeneratorFiles, params: []string{"field"}},
+	{code: "plaintext-secret", format: errMsgPlaintextSecret, params: []string{"value"}},
+	{code: "duplicate-generator", format: errMsgDuplicateGenerator, params: []string{"kind", "value"}},
+	{code: "duplicate-overlay", format: errMsgDuplicateOverlay, params: []string{"value"}},
+	{code: "unknown-component", format: errMsgUnknownComponent, params: []string{"value"}},
+	{code: "unknown-container", format: errMsgUnknownContainer, params: []string{"value", "component"}},
+	{code: "unknown-ingress", format: errMsgUnknownIngress, params: []string{"value", "component"}},
+	{code: "unknown-cluster", format: errMsgNoCluster, params: []string{"region", "env"}},
+	{code: "flux-cluster", format: errMsgFluxCluster, params: []string{"value"}},
+	{code: "not-a-branch", format: errMsgNotABranch, params: []string{"value"}},
+	{code: "unknown-config-map", format: errMsgUnknownConfigMap, params: []string{"value"}},
+	{code: "unknown-persistent-volume", format: errMsgUnknownPV, params: []string{"value"}},
+	{code: "unknown-port", format: errMsgUnknownPort, params: []string{"value", "service"}},
+	{code: "not-mounted", format: errMsgNotMounted, params: []string{"value"}},
+	{code: "duplicate", format: errMsgDuplicate, params: []string{"value", "kind"}},
+	{code: "duplicate-volume", format: errMsgDuplicateVolume, params: []string{"value", "kind"}},
+	{code: "probe-path", format: errMsgProbePath, params: []string{"value"}},
+	{code: "unknown-container-port", format: errMsgUnknownContainerPort, params: []string{"value", "container"}},
+	{code: "policy-min-replicas", format: errMsgPolicyMinReplicas, params: []string{"policy", "min"}},
+	{code: "policy-required-probe", format: errMsgPolicyProbe, params: []string{"policy", "kind"}},
+	{code: "policy-allowed-registries", format: errMsgPolicyRegistry, params: []string{"value", "policy", "allowed"}},
+	{code: "policy-max-capacity", format: errMsgPolicyCapacity, params: []string{"policy", "max"}},
+	{code: "policy-forbidden-service-type", format: errMsgPolicyServiceType, params: []string{"policy", "value"}},
+	{code: "schema", format: errMsgSchema, params: []string{"reason"}},
+	{code: "unserved-kind", format: errMsgUnservedKind, params: []string{"version", "kind"}},
+	{code: "rego-deny", format: errMsgRegoDeny, params: []string{"policy", "resource", "reason"}},
+	{code: "rego-warn", format: warnMsgRegoWarn, params: []string{"policy", "resource", "reason"}},
+
+	{code: "latest-tag", format: warnMsgLatestTag, params: []string{"value"}, warning: true},
+	{code: "single-replica", format: warnMsgSingleReplica, params: []string{"component", "env"}, warning: true},
+	{code: "missing-probe", format: warnMsgMissingProbe, params: []string{"container", "kind"}, warning: true},
+
+	{code: "missing-resources", format: infoMsgMissingResources, params: []string{"container"}},
+})
+
+// regexVerb matches the verbs of the message formats
+var regexVerb = regexp.MustCompile(`%[qsd]`)
+
+// indexIssueCatalog indexes the entries by format. It panics when a format
+// has two entries or a number of verbs other than its number of parameters.
+func indexIssueCatalog(catalog []*issueFormat) map[string]*issueFormat {
+	index := map[string]*issueFormat{}
+	for _, entry := range catalog {
+		if _, ok := index[entry.format]; ok {
+			panic(fmt.Sprintf("duplicate issue format %q", entry.format))
+		}
+		if verbs := len(regexVerb.FindAllString(entry.format, -1)); verbs != len(entry.params) {
+			panic(fmt.Sprintf("issue format %q has %d verbs and %d parameters", entry.format, verbs, len(entry.params)))
+		}
+		index[entry.format] = entry
+	}
+	return index
+}
+
+// validationError is a validation message with the code of its kind of issue
+// and the values it is built from, by parameter name. It is stored in the
+// nested errors, warnings and informational findings, and is marshaled as its
+// message.
+type validationError struct {
+	code    string
+	params  map[string]string
+	message string
+}
+
+// newValidationError formats a message of the catalog with the values of its
+// verbs, like fmt.Sprintf
+func newValidationError(format string, args ...interface{}) validationError {
+	err := validationError{code: issueCodeInvalid, message: fmt.Sprintf(format, args...)}
+	entry, ok := issueCatalog[format]
+	if !ok {
+		return err
+	}
+
+	err.code = entry.code
+	for i, name := range entry.params {
+		if err.params == nil {
+			err.params = map[string]string{}
+		}
+		err.params[name] = fmt.Sprint(args[i])
+	}
+	return err
+}
+
+func (e validationError) Error() string {
+	return e.message
+}
+
+// MarshalJSON marshals the error as its message
+func (e validationError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.message)
+}
+
+// isWarningCode returns whether a code is the code of a warning an env can
+// promote
+func isWarningCode(code string) bool {
+	for _, entry := range issueCatalog {
+		if entry.warning && entry.code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// NewValidationResponse returns the response of the validation errors of an
+// application: the nested errors and the same errors as issues
+func NewValidationResponse(errors map[string]interface{}) *models.ValidationResponse {
+	return &models.ValidationResponse{
+		Errors: errors,
+		Issues: Issues(errors, models.ValidationIssueSeverityError),
+	}
+}
+
+// NewFindingsResponse returns the response of the findings of an application:
+// the nested messages of each severity and all of them as issues
+func NewFindingsResponse(findings *Findings) *models.ValidationResponse {
+	var issues []*models.ValidationIssue
+	collectIssues(findings.Errors, "", models.ValidationIssueSeverityError, &issues)
+	collectIssues(findings.Warnings, "", models.ValidationIssueSeverityWarning, &issues)
+	collectIssues(findings.Infos, "", models.ValidationIssueSeverityInfo, &issues)
+	sortIssues(issues)
+
+	return &models.ValidationResponse{
+		Errors:   findings.Errors,
+		Warnings: findings.Warnings,
+		Infos:    findings.Infos,
+		Issues:   issues,
+	}
+}
+
+// Issues flattens nested validation errors into issues of a severity, each
+// with the JSON pointer of its field and the code and parameters of its
+// message. The issues are ordered by path.
+func Issues(errors map[string]interface{}, severity string) []*models.ValidationIssue {
+	var issues []*models.ValidationIssue
+	collectIssues(errors, "", severity, &issues)
+	sortIssues(issues)
+	return issues
+}
+
+// issueSeverities orders the issues of a field from the most severe
+var issueSeverities = map[string]int{
+	models.ValidationIssueSeverityError:   0,
+	models.ValidationIssueSeverityWarning: 1,
+	models.ValidationIssueSeverityInfo:    2,
+}
+
+// sortIssues orders issues by path, then severity and message
+func sortIssues(issues []*models.ValidationIssue) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Path != issues[j].Path {
+			return issues[i].Path < issues[j].Path
+		}
+		if issues[i].Severity != issues[j].Severity {
+			return issueSeverities[issues[i].Severity] < issueSeverities[issues[j].Severity]
+		}
+		return issues[i].Message < issues[j].Message
+	})
+}
+
+func collectIssues(value interface{}, pointer, severity string, issues *[]*models.ValidationIssue) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range value {
+			collectIssues(nested, appendPointer(pointer, key), severity, issues)
+		}
+	case []interface{}:
+		for i, nested := range value {
+			collectIssues(nested, appendPointer(pointer, strconv.Itoa(i)), severity, issues)
+		}
+	case []string:
+		for i, message := range value {
+			collectIssues(message, appendPointer(pointer, strconv.Itoa(i)), severity, issues)
+		}
+	case validationError:
+		*issues = append(*issues, &models.ValidationIssue{
+			Path:     pointer,
+			Code:     value.code,
+			Severity: severity,
+			Message:  value.message,
+			Params:   value.params,
+		})
+	case string:
+		*issues = append(*issues, &models.ValidationIssue{
+			Path:     pointer,
+			Code:     issueCodeInvalid,
+			Severity: severity,
+			Message:  value,
+		})
+	}
+}
+
+// appendPointer appends a key to a JSON pointer. The errors of the
+// application itself are keyed by "".
+func appendPointer(pointer, key string) string {
+	if key == "" {
+		return pointer
+	}
+	key = strings.Replace(key, "~", "~0", -1)
+	key = strings.Replace(key, "/", "~1", -1)
+	return pointer + "/" + key
+}
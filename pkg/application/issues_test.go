@@ -0,0 +1,112 @@
+package application_test
+
+import (
+	"reflect"
+	"testing"
+
+	"deploy-wizard/gen/models"
+	"deploy-wizard/pkg/application"
+)
+
+func TestIssues(t *testing.T) {
+	app := newDeterminismApplication(models.DestinationFormatKustomize)
+	app.Metadata.Name = ""
+	app.Metadata.Labels.Env = "QA"
+	app.Spec.Destination.Format = "jsonnet"
+	app.Spec.Components[0].Service.Name = "Web"
+	app.Spec.Components[0].Replicas = -1
+	app.Spec.Components[1].Ingresses[0].Paths[0].PortName = "https"
+	app.Spec.Components[2].Service.Ports[0].Port = 0
+	app.Spec.Components[2].Containers[0].Resources = &models.ResourceRequirements{Limits: &models.ResourceList{CPU: "half"}}
+	app.Spec.Overlays[0].Components[0].Name = "cron"
+	app.Spec.Destination.Finalizers = []string{"say \"hi\" must be one of\n"}
+
+	errs := application.ValidateApplication(app, application.RequireCluster(application.Clusters{
+		{Region: "STL", Env: "Dev", Server: "https://stl-dev.mc.int"},
+	}))
+	issues := application.Issues(errs, models.ValidationIssueSeverityError)
+
+	expected := map[string]*models.ValidationIssue{
+		"/metadata/name":                  {Code: "required", Params: map[string]string{"field": "name"}},
+		"/metadata/labels/region":         {Code: "unknown-cluster", Params: map[string]string{"region": "STL", "env": "QA"}},
+		"/spec/destination/format":        {Code: "one-of", Params: map[string]string{"value": "jsonnet", "allowed": "kustomize, helm"}},
+		"/spec/components/0/service/name": {Code: "dns1035-label", Params: map[string]string{"value": "Web"}},
+		"/spec/components/0/replicas":     {Code: "negative", Params: map[string]string{"field": "replicas"}},
+		"/spec/components/1/ingresses/0/paths/0/portName": {
+			Code: "unknown-port", Params: map[string]string{"value": "https", "service": "api"},
+		},
+		"/spec/components/2/service/ports/0/port": {Code: "port-number", Params: map[string]string{"value": "0"}},
+		"/spec/components/2/containers/0/resources/limits/cpu": {
+			Code: "quantity", Params: map[string]string{"value": "half"},
+		},
+		"/spec/overlays/0/components/0/name": {Code: "unknown-component", Params: map[string]string{"value": "cron"}},
+		"/spec/destination/finalizers/0": {
+			Code: "control-characters", Params: map[string]string{"value": "say \"hi\" must be one of\n"},
+		},
+	}
+
+	found := map[string]bool{}
+	for i, issue := range issues {
+		if i > 0 && issues[i-1].Path > issue.Path {
+			t.Errorf("expected the issues ordered by path, got %s before %s", issues[i-1].Path, issue.Path)
+		}
+		if issue.Severity != models.ValidationIssueSeverityError || issue.Message == "" {
+			t.Errorf("%s: expected an error with a message, got %+v", issue.Path, issue)
+		}
+		if issue.Code == "invalid" {
+			t.Errorf("%s: expected a code for %q", issue.Path, issue.Message)
+		}
+		if want, ok := expected[issue.Path]; ok {
+			found[issue.Path] = true
+			if issue.Code != want.Code || !reflect.DeepEqual(issue.Params, want.Params) {
+				t.Errorf("%s: expected code %s with %v, got %s with %v", issue.Path, want.Code, want.Params, issue.Code, issue.Params)
+			}
+		}
+	}
+	for path := range expected {
+		if !found[path] {
+			t.Errorf("expected an issue at %s, got %s", path, toJSON(t, issues))
+		}
+	}
+}
+
+func TestIssuesPointers(t *testing.T) {
+	errs := map[string]interface{}{
+		"": application.RequiredErrors("metadata")["metadata"],
+		"manifests": map[string]interface{}{
+			"base/deployment-web.yaml": []string{"spec.replicas: Invalid type. Expected: integer, given: string"},
+		},
+		"labels": application.RequiredErrors("a~b"),
+	}
+
+	expected := []*models.ValidationIssue{
+		{Path: "", Code: "required", Severity: "warning", Message: `"metadata" is a required field`, Params: map[string]string{"field": "metadata"}},
+		{Path: "/labels/a~0b", Code: "required", Severity: "warning", Message: `"a~b" is a required field`, Params: map[string]string{"field": "a~b"}},
+		{
+			Path: "/manifests/base~1deployment-web.yaml/0", Code: "invalid", Severity: "warning",
+			Message: "spec.replicas: Invalid type. Expected: integer, given: string",
+		},
+	}
+	if issues := application.Issues(errs, models.ValidationIssueSeverityWarning); !reflect.DeepEqual(issues, expected) {
+		t.Errorf("expected %s, got %s", toJSON(t, expected), toJSON(t, issues))
+	}
+
+	// the nested errors marshal as their messages
+	if got := toJSON(t, errs["labels"]); got != `{"a~b":"\"a~b\" is a required field"}` {
+		t.Errorf("expected the message of the error, got %s", got)
+	}
+}
+
+func TestNewValidationResponse(t *testing.T) {
+	response := application.NewValidationResponse(application.ValidateApplication(newDeterminismApplication(models.DestinationFormatKustomize)))
+	if len(response.Errors) > 0 || len(response.Issues) > 0 {
+		t.Errorf("expected neither errors nor issues, got %s", toJSON(t, response))
+	}
+}
+
+func TestNotABranchErrors(t *testing.T) {
+	issues := application.Issues(application.NotABranchErrors(&models.Destination{TargetRevision: "v1"}), models.ValidationIssueSeverityError)
+	if len(issues) != 1 || issues[0].Path != "/spec/destination/targetRevision" || issues[0].Code != "not-a-branch" || issues[0].Params["value"] != "v1" {
+		t.Errorf("expected a not-a-branch issue for the target revision, got %s", toJSON(t, issues))
+	}
+}
@@ -0,0 +1,113 @@
+package application
+
+import (
+	"strconv"
+
+	"deploy-wizard/gen/models"
+	"deploy-wizard/pkg/schema"
+)
+
+const errMsgUnservedKind = "Kubernetes %s serves no API version of %s"
+
+// kindAPIVersions lists the API versions of the kinds that moved between API
+// groups or versions across the supported Kubernetes versions, newest first
+var kindAPIVersions = map[string][]string{
+	"CronJob":                 {"batch/v1", "batch/v1beta1"},
+	"HorizontalPodAutoscaler": {"autoscaling/v2", "autoscaling/v2beta2", "autoscaling/v1"},
+	"Ingress":                 {"networking.k8s.io/v1", "networking.k8s.io/v1beta1", "extensions/v1beta1"},
+	"PodDisruptionBudget":     {"policy/v1", "policy/v1beta1"},
+}
+
+func isKubernetesVersion(version string) bool {
+	for _, v := range schema.Versions() {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
+
+// KubernetesVersion returns the Kubernetes version an application is deployed
+// to: the version of its cluster if set, else the version of its env, else
+// the version of the renderer
+func (r *Renderer) KubernetesVersion(app *models.Application) string {
+	labels := app.Metadata.Labels
+	if cluster, err := r.clusters.Lookup(labels.Region, labels.Env); err == nil && cluster.KubernetesVersion != "" {
+		return cluster.KubernetesVersion
+	}
+	if environment := r.environments.Lookup(labels.Env); environment != nil && environment.KubernetesVersion != "" {
+		return environment.KubernetesVersion
+	}
+	return r.kubernetesVersion
+}
+
+// APIVersions returns, by kind, the newest API version of the kinds of
+// kindAPIVersions that the Kubernetes version of an application serves.
+// Kinds it serves no version of are left out.
+func (r *Renderer) APIVersions(app *models.Application) map[string]string {
+	validator := r.validatorFor(app)
+
+	apiVersions := map[string]string{}
+	for kind, versions := range kindAPIVersions {
+		for _, apiVersion := range versions {
+			if validator.Has(apiVersion, kind) {
+				apiVersions[kind] = apiVersion
+				break
+			}
+		}
+	}
+	return apiVersions
+}
+
+// ValidateAPIVersions reports the resources of an application whose kind the
+// Kubernetes version it is deployed to serves no version of. Both the
+// Kustomize templates and the Helm chart take the API version of those kinds
+// from APIVersions, which leaves them out.
+func (r *Renderer) ValidateAPIVersions(app *models.Application) map[string]interface{} {
+	errors := map[string]interface{}{}
+
+	apiVersions := r.APIVersions(app)
+	if _, ok := apiVersions["Ingress"]; ok {
+		return errors
+	}
+
+	version := r.KubernetesVersion(app)
+	for i, component := range app.Spec.Components {
+		for j := range component.Ingresses {
+			setValidationError(errors, newValidationError(errMsgUnservedKind, version, "Ingress"),
+				"spec", "components", strconv.Itoa(i), "ingresses", strconv.Itoa(j))
+		}
+	}
+	return errors
+}
+
+// validatorFor returns the validator of the Kubernetes version of an
+// application. NewRenderer loads the validator of every configured version.
+func (r *Renderer) validatorFor(app *models.Application) *schema.Validator {
+	return r.validators[r.KubernetesVersion(app)]
+}
+
+// loadValidators loads the validators of the renderer version and of every
+// version configured for a cluster or env
+func (r *Renderer) loadValidators() error {
+	versions := []string{r.kubernetesVersion}
+	for _, cluster := range r.clusters {
+		versions = append(versions, cluster.KubernetesVersion)
+	}
+	for _, environment := range r.environments {
+		versions = append(versions, environment.KubernetesVersion)
+	}
+
+	r.validators = map[string]*schema.Validator{}
+	for _, version := range versions {
+		if _, ok := r.validators[version]; ok || version == "" {
+			continue
+		}
+		validator, err := schema.NewValidator(version)
+		if err != nil {
+			return err
+		}
+		r.validators[version] = validator
+	}
+	return nil
+}
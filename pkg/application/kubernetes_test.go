@@ -0,0 +1,106 @@
+package application_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"deploy-wizard/pkg/application"
+)
+
+func TestKubernetesVersion(t *testing.T) {
+	app := application.ApplyDefaults(validApplication)
+
+	tests := []struct {
+		name         string
+		clusters     application.Clusters
+		environments application.Environments
+		want         string
+	}{
+		{"default", nil, nil, "1.19"},
+		{"env", nil, application.Environments{{Env: "Dev", KubernetesVersion: "1.22"}}, "1.22"},
+		{
+			"cluster",
+			application.Clusters{{Region: "STL", Env: "Dev", Name: "stl-dev", KubernetesVersion: "1.25"}},
+			application.Environments{{Env: "Dev", KubernetesVersion: "1.22"}},
+			"1.25",
+		},
+		{
+			"cluster without version",
+			application.Clusters{{Region: "STL", Env: "Dev", Name: "stl-dev"}},
+			application.Environments{{Env: "Dev", KubernetesVersion: "1.22"}},
+			"1.22",
+		},
+	}
+
+	for _, tt := range tests {
+		renderer, err := application.NewRenderer("", application.WithClusters(tt.clusters), application.WithEnvironments(tt.environments))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := renderer.KubernetesVersion(app); got != tt.want {
+			t.Errorf("%s: expected Kubernetes %s, got %s", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestAPIVersions(t *testing.T) {
+	app := application.ApplyDefaults(validApplication)
+
+	tests := []struct {
+		version string
+		want    map[string]string
+	}{
+		{"1.19", map[string]string{
+			"CronJob":                 "batch/v1beta1",
+			"HorizontalPodAutoscaler": "autoscaling/v2beta2",
+			"Ingress":                 "networking.k8s.io/v1",
+			"PodDisruptionBudget":     "policy/v1beta1",
+		}},
+		{"1.25", map[string]string{
+			"CronJob":                 "batch/v1",
+			"HorizontalPodAutoscaler": "autoscaling/v2",
+			"Ingress":                 "networking.k8s.io/v1",
+			"PodDisruptionBudget":     "policy/v1",
+		}},
+	}
+
+	for _, tt := range tests {
+		renderer, err := application.NewRenderer("", application.WithKubernetesVersion(tt.version))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := renderer.APIVersions(app); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Kubernetes %s: expected %v, got %v", tt.version, tt.want, got)
+		}
+	}
+}
+
+func TestValidateManifestsUnservedKind(t *testing.T) {
+	app := application.ApplyDefaults(validApplication)
+	manifests := map[string]string{
+		"base/pdb-app1.yaml": "apiVersion: policy/v1beta1\nkind: PodDisruptionBudget\nmetadata:\n  name: app1\nspec:\n  minAvailable: 1\n",
+	}
+
+	renderer, err := application.NewRenderer("", application.WithEnvironments(application.Environments{{Env: "Dev", KubernetesVersion: "1.19"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if problems, err := renderer.ValidateManifests(app, manifests); err != nil || len(problems) > 0 {
+		t.Errorf("expected policy/v1beta1 to be served by Kubernetes 1.19, got %v", problems)
+	}
+
+	renderer, err = application.NewRenderer("", application.WithClusters(application.Clusters{
+		{Region: "STL", Env: "Dev", Name: "stl-dev", KubernetesVersion: "1.25"},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	problems, err := renderer.ValidateManifests(app, manifests)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if problems := problems["base/pdb-app1.yaml"]; len(problems) != 1 || !strings.Contains(problems[0], "not served by Kubernetes 1.25") {
+		t.Errorf("expected policy/v1beta1 not to be served by Kubernetes 1.25, got %v", problems)
+	}
+}
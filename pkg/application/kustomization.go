@@ -0,0 +1,305 @@
+package application
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"deploy-wizard/gen/models"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Kustomization is the content of a kustomization.yaml
+type Kustomization struct {
+	// Resources are the manifest files or directories the kustomization builds on
+	Resources []string
+	// Patches are the strategic merge patch files applied to the resources
+	Patches []string
+
+	Namespace          string
+	NamePrefix         string
+	NameSuffix         string
+	CommonLabels       map[string]string
+	CommonAnnotations  map[string]string
+	Images             []*models.KustomizeImage
+	ConfigMapGenerator []*models.ConfigMapGenerator
+	SecretGenerator    []*models.SecretGenerator
+}
+
+// newBaseKustomization returns the kustomization of the base manifests,
+// applying the kustomize features of the application
+func newBaseKustomization(app *models.Application, resources []string) *Kustomization {
+	sort.Strings(resources)
+	kustomization := &Kustomization{
+		Resources: resources,
+		Namespace: app.Metadata.Namespace,
+	}
+
+	if k := app.Spec.Kustomize; k != nil {
+		if k.Namespace != "" {
+			kustomization.Namespace = k.Namespace
+		}
+		kustomization.NamePrefix = k.NamePrefix
+		kustomization.NameSuffix = k.NameSuffix
+		kustomization.CommonLabels = k.CommonLabels
+		kustomization.CommonAnnotations = k.CommonAnnotations
+		kustomization.Images = k.Images
+		kustomization.ConfigMapGenerator = k.ConfigMapGenerator
+		kustomization.SecretGenerator = k.SecretGenerator
+	}
+
+	return kustomization
+}
+
+// newOverlayKustomization returns the kustomization of an overlay, which
+// builds on the base manifests
+func newOverlayKustomization(patches []string, images []*models.KustomizeImage) *Kustomization {
+	return &Kustomization{
+		Resources: []string{path.Join("..", "..", baseDir)},
+		Patches:   patches,
+		Images:    images,
+	}
+}
+
+// BuildKustomization builds a kustomization file with the templates of an
+// application
+func (r *Renderer) BuildKustomization(app *models.Application, kustomization *Kustomization) (string, error) {
+	t, err := r.lookupTemplate(app, templates["kustomization"][0])
+	if err != nil {
+		return "", err
+	}
+
+	log.Infof("rendering %q", t.Name())
+	return renderTemplate(t, kustomization)
+}
+
+// podSpecKinds are the kinds whose pod template kustomize transforms
+var podSpecKinds = map[string]bool{"Deployment": true, "StatefulSet": true, "DaemonSet": true, "ReplicaSet": true, "Job": true}
+
+// kustomizeResources applies the transformers of kustomizations, in order, to
+// rendered resources the way kustomize build applies those of a base and then
+// those of its overlay: the namespace, the name prefix and suffix along with
+// the references to the renamed resources, the common labels and annotations
+// and the images. Patches are not applied; the resources are rendered with
+// them.
+func kustomizeResources(resources map[string]string, kustomizations ...*Kustomization) (map[string]string, error) {
+	docs := map[string][]map[interface{}]interface{}{}
+	for filename, content := range resources {
+		decoder := yaml.NewDecoder(strings.NewReader(content))
+		for {
+			var doc map[interface{}]interface{}
+			err := decoder.Decode(&doc)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse %q", filename)
+			}
+			if doc != nil {
+				docs[filename] = append(docs[filename], doc)
+			}
+		}
+	}
+
+	for _, kustomization := range kustomizations {
+		// references are renamed along with the resources of the kustomization
+		names := map[string]bool{}
+		for _, fileDocs := range docs {
+			for _, doc := range fileDocs {
+				names[resourceKey(doc)] = true
+			}
+		}
+		for _, fileDocs := range docs {
+			for _, doc := range fileDocs {
+				kustomization.transform(doc, names)
+			}
+		}
+	}
+
+	result := map[string]string{}
+	for filename, fileDocs := range docs {
+		var contents []string
+		for _, doc := range fileDocs {
+			content, err := yaml.Marshal(doc)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to marshal %q", filename)
+			}
+			contents = append(contents, string(content))
+		}
+		result[filename] = strings.Join(contents, "---\n")
+	}
+	return result, nil
+}
+
+// transform applies the transformers of a kustomization to a resource. Names
+// holds the kind and name of the resources of the kustomization.
+func (k *Kustomization) transform(doc map[interface{}]interface{}, names map[string]bool) {
+	metadata := childMap(doc, "metadata")
+	if metadata == nil {
+		return
+	}
+	if k.Namespace != "" {
+		metadata["namespace"] = k.Namespace
+	}
+	if name, ok := metadata["name"].(string); ok {
+		metadata["name"] = k.rename(name)
+	}
+	addStrings(metadata, "labels", k.CommonLabels)
+	addStrings(metadata, "annotations", k.CommonAnnotations)
+
+	spec := childMap(doc, "spec")
+	if spec == nil {
+		return
+	}
+	switch kind, _ := doc["kind"].(string); {
+	case kind == "Service":
+		addStrings(spec, "selector", k.CommonLabels)
+	case kind == "Ingress":
+		k.transformIngress(spec, names)
+	case podSpecKinds[kind]:
+		if selector := childMap(spec, "selector"); selector != nil {
+			addStrings(selector, "matchLabels", k.CommonLabels)
+		}
+		if template := childMap(spec, "template"); template != nil {
+			if templateMetadata := childMap(template, "metadata"); templateMetadata != nil {
+				addStrings(templateMetadata, "labels", k.CommonLabels)
+				addStrings(templateMetadata, "annotations", k.CommonAnnotations)
+			}
+			if podSpec := childMap(template, "spec"); podSpec != nil {
+				k.transformPodSpec(podSpec, names)
+			}
+		}
+	}
+}
+
+// transformPodSpec renames the config maps and claims a pod mounts and
+// overrides the images of its containers
+func (k *Kustomization) transformPodSpec(podSpec map[interface{}]interface{}, names map[string]bool) {
+	for _, volume := range childMaps(podSpec, "volumes") {
+		if configMap := childMap(volume, "configMap"); configMap != nil {
+			k.renameReference(configMap, "name", "ConfigMap", names)
+		}
+		if claim := childMap(volume, "persistentVolumeClaim"); claim != nil {
+			k.renameReference(claim, "claimName", "PersistentVolumeClaim", names)
+		}
+	}
+	for _, key := range []string{"initContainers", "containers"} {
+		for _, container := range childMaps(podSpec, key) {
+			if image, ok := container["image"].(string); ok {
+				container["image"] = k.transformImage(image)
+			}
+		}
+	}
+}
+
+// transformIngress renames the services an ingress routes to
+func (k *Kustomization) transformIngress(spec map[interface{}]interface{}, names map[string]bool) {
+	for _, rule := range childMaps(spec, "rules") {
+		for _, path := range childMaps(childMap(rule, "http"), "paths") {
+			backend := childMap(path, "backend")
+			if service := childMap(backend, "service"); service != nil {
+				k.renameReference(service, "name", "Service", names)
+			} else if backend != nil {
+				k.renameReference(backend, "serviceName", "Service", names)
+			}
+		}
+	}
+}
+
+func (k *Kustomization) rename(name string) string {
+	return k.NamePrefix + name + k.NameSuffix
+}
+
+// renameReference renames a reference to a resource of the kustomization.
+// References to other resources keep their name.
+func (k *Kustomization) renameReference(m map[interface{}]interface{}, key, kind string, names map[string]bool) {
+	if name, ok := m[key].(string); ok && names[kind+"/"+name] {
+		m[key] = k.rename(name)
+	}
+}
+
+// transformImage applies the first image override of the kustomization whose
+// name matches the name of an image reference. A digest replaces the tag.
+func (k *Kustomization) transformImage(image string) string {
+	name, tag, digest := parseImage(image)
+	for _, override := range k.Images {
+		if override.Name != name {
+			continue
+		}
+		if override.NewName != "" {
+			name = override.NewName
+		}
+		if override.NewTag != "" {
+			tag, digest = override.NewTag, ""
+		}
+		if override.Digest != "" {
+			tag, digest = "", override.Digest
+		}
+		break
+	}
+
+	if tag != "" {
+		name += ":" + tag
+	}
+	if digest != "" {
+		name += "@" + digest
+	}
+	return name
+}
+
+// parseImage splits an image reference into its name, tag and digest
+func parseImage(image string) (string, string, string) {
+	var digest string
+	if i := strings.Index(image, "@"); i >= 0 {
+		image, digest = image[:i], image[i+1:]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[:i], image[i+1:], digest
+	}
+	return image, "", digest
+}
+
+// resourceKey returns the kind and name of a resource
+func resourceKey(doc map[interface{}]interface{}) string {
+	name, _ := childMap(doc, "metadata")["name"].(string)
+	return fmt.Sprintf("%v/%s", doc["kind"], name)
+}
+
+// childMap returns the mapping of a key of a mapping, or nil
+func childMap(m map[interface{}]interface{}, key string) map[interface{}]interface{} {
+	child, _ := m[key].(map[interface{}]interface{})
+	return child
+}
+
+// childMaps returns the mappings of the sequence of a key of a mapping
+func childMaps(m map[interface{}]interface{}, key string) []map[interface{}]interface{} {
+	items, _ := m[key].([]interface{})
+	var children []map[interface{}]interface{}
+	for _, item := range items {
+		if child, ok := item.(map[interface{}]interface{}); ok {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// addStrings adds values to the mapping of a key of a mapping, creating it
+// if there are values to add
+func addStrings(m map[interface{}]interface{}, key string, values map[string]string) {
+	if len(values) == 0 {
+		return
+	}
+	child := childMap(m, key)
+	if child == nil {
+		child = map[interface{}]interface{}{}
+		m[key] = child
+	}
+	for name, value := range values {
+		child[name] = value
+	}
+}
@@ -0,0 +1,176 @@
+package application_test
+
+import (
+	"strings"
+	"testing"
+
+	"deploy-wizard/gen/models"
+	"deploy-wizard/pkg/application"
+
+	"github.com/andreyvit/diff"
+)
+
+var expectedBaseKustomization = `namespace: tenant1-dev
+namePrefix: dev-
+commonLabels:
+	cost-center: "42"
+	team: "tenant1"
+commonAnnotations:
+	owner: "tenant1@mc.int"
+resources:
+- configmap-config.yaml
+- deployment-app1.yaml
+- ingress-app1.mc.int.yaml
+- persistent-volume-data.yaml
+- service-account.yaml
+- service-app1.yaml
+images:
+- name: nginx
+	newName: registry.mc.int/nginx
+	newTag: "1.19"
+configMapGenerator:
+- name: settings
+	literals:
+	- "LOG_LEVEL=debug"
+	files:
+	- settings.properties
+secretGenerator:
+- name: credentials
+	type: kubernetes.io/basic-auth
+	behavior: create
+	envs:
+	- credentials.env
+`
+
+func TestBuildKustomizationBase(t *testing.T) {
+	app := application.ApplyDefaults(validApplication)
+	app.Spec.Kustomize = &models.Kustomize{
+		Namespace:         "tenant1-dev",
+		NamePrefix:        "dev-",
+		CommonLabels:      map[string]string{"team": "tenant1", "cost-center": "42"},
+		CommonAnnotations: map[string]string{"owner": "tenant1@mc.int"},
+		Images: []*models.KustomizeImage{
+			{Name: "nginx", NewName: "registry.mc.int/nginx", NewTag: "1.19"},
+		},
+		ConfigMapGenerator: []*models.ConfigMapGenerator{
+			{Name: "settings", Literals: []string{"LOG_LEVEL=debug"}, Files: []string{"settings.properties"}},
+		},
+		SecretGenerator: []*models.SecretGenerator{
+			{Name: "credentials", Type: "kubernetes.io/basic-auth", Behavior: "create", Envs: []string{"credentials.env"}},
+		},
+	}
+	defer func() { app.Spec.Kustomize = nil }()
+
+	renderer, err := application.NewRenderer("../../_templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := renderer.RenderManifests(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := strings.Replace(expectedBaseKustomization, "\t", "  ", -1)
+	if result := results["base/kustomization.yaml"]; result != expected {
+		t.Errorf("Result not as expected:\n%v", diff.LineDiff(result, expected))
+	}
+}
+
+func TestBuildKustomizationNamespace(t *testing.T) {
+	renderer, err := application.NewRenderer("../../_templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := renderer.BuildKustomization(validApplication, &application.Kustomization{
+		Namespace: "tenant1",
+		Resources: []string{"service-account.yaml"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := "namespace: tenant1\nresources:\n- service-account.yaml\n"; result != expected {
+		t.Errorf("Result not as expected:\n%v", diff.LineDiff(result, expected))
+	}
+}
+
+func TestValidateKustomize(t *testing.T) {
+	errs := application.ValidateKustomize(&models.Kustomize{
+		Images: []*models.KustomizeImage{{Name: "nginx"}},
+		ConfigMapGenerator: []*models.ConfigMapGenerator{
+			{Name: "settings", Literals: []string{"LOG_LEVEL"}},
+			{Name: "settings", Behavior: "append", Files: []string{"settings.properties"}},
+		},
+		SecretGenerator: []*models.SecretGenerator{{Name: "credentials"}},
+	})
+
+	for _, field := range []string{"images", "configMapGenerator", "secretGenerator"} {
+		if _, ok := errs[field]; !ok {
+			t.Errorf("expected a %s error, got %v", field, errs)
+		}
+	}
+
+	generators, _ := errs["configMapGenerator"].(map[string]interface{})
+	first, _ := generators["0"].(map[string]interface{})
+	if _, ok := first["literals"]; !ok || len(first) != 1 {
+		t.Errorf("expected only a literals error for the first generator, got %v", first)
+	}
+	second, _ := generators["1"].(map[string]interface{})
+	for _, field := range []string{"name", "behavior", "files"} {
+		if _, ok := second[field]; !ok {
+			t.Errorf("expected a %s error for the second generator, got %v", field, second)
+		}
+	}
+}
+
+func TestValidateKustomizeGeneratorsAreCommitted(t *testing.T) {
+	errs := application.ValidateKustomize(&models.Kustomize{
+		ConfigMapGenerator: []*models.ConfigMapGenerator{
+			{Name: "settings", Envs: []string{"settings.env"}},
+		},
+		SecretGenerator: []*models.SecretGenerator{
+			{Name: "credentials", Literals: []string{"password=secret"}},
+		},
+	})
+
+	// the env file is not in the repository and the literals would be in plain text
+	if message := validationError(errs, "configMapGenerator.0.envs"); !strings.HasPrefix(message, "envs are not written") {
+		t.Errorf("expected an envs error, got %v", errs)
+	}
+	if message := validationError(errs, "secretGenerator.0"); !strings.Contains(message, "plain text") {
+		t.Errorf("expected the Secret generator to be refused, got %v", errs)
+	}
+}
+
+func TestRenderMountsGeneratedConfigMap(t *testing.T) {
+	app := newDeterminismApplication(models.DestinationFormatKustomize)
+	app.Spec.Kustomize.ConfigMapGenerator = []*models.ConfigMapGenerator{{Name: "settings", Literals: []string{"KEY=value"}}}
+	container := app.Spec.Components[0].Containers[0]
+	container.Volumes = append(container.Volumes[:5:5], &models.VolumeMount{MountPath: "/settings", Name: "settings", Type: models.VolumeMountTypeConfigMap})
+	if errs := application.ValidateApplication(app); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	renderer, err := application.NewRenderer("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := renderer.RenderManifests(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// kustomize replaces the name of the volume's ConfigMap with the hashed
+	// name of the generated one, so pods roll when its literals change
+	volume := "      - name: settings\n        configMap:\n          name: settings\n"
+	for _, name := range []string{"web", "api", "worker"} {
+		if deployment := results["base/deployment-"+name+".yaml"]; !strings.Contains(deployment, volume) {
+			t.Errorf("expected deployment %s to have a volume of the generated ConfigMap, got\n%s", name, deployment)
+		}
+	}
+	if kustomization := results["base/kustomization.yaml"]; !strings.Contains(kustomization, "configMapGenerator:\n- name: settings\n") {
+		t.Errorf("expected the ConfigMap to be generated, got\n%s", kustomization)
+	}
+}
@@ -0,0 +1,313 @@
+package application
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"deploy-wizard/gen/models"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	baseDir     = "base"
+	overlaysDir = "overlays"
+)
+
+// overlayDir returns the directory of the overlay of an env
+func overlayDir(env string) string {
+	return path.Join(overlaysDir, strings.ToLower(env))
+}
+
+// OverlayPath returns the path of the overlay the application is deployed
+// with, relative to the repository root
+func OverlayPath(app *models.Application) string {
+	return strings.TrimPrefix(path.Join(app.Spec.Destination.Path, overlayDir(app.Metadata.Labels.Env)), "/")
+}
+
+// overlayEnvs returns the envs overlays are rendered for: the env of the
+// application and every env it has adjustments for
+func overlayEnvs(app *models.Application) []string {
+	envs := []string{app.Metadata.Labels.Env}
+	for _, overlay := range app.Spec.Overlays {
+		if !strings.EqualFold(overlay.Env, app.Metadata.Labels.Env) {
+			envs = append(envs, overlay.Env)
+		}
+	}
+	return envs
+}
+
+func findOverlay(app *models.Application, env string) *models.Overlay {
+	for _, overlay := range app.Spec.Overlays {
+		if strings.EqualFold(overlay.Env, env) {
+			return overlay
+		}
+	}
+	return nil
+}
+
+func findComponent(app *models.Application, serviceName string) *models.Component {
+	for _, component := range app.Spec.Components {
+		if component.Service != nil && component.Service.Name == serviceName {
+			return component
+		}
+	}
+	return nil
+}
+
+func findContainer(component *models.Component, name string) *models.Container {
+	for _, container := range component.Containers {
+		if container.Name == name {
+			return container
+		}
+	}
+	return nil
+}
+
+func findIngress(component *models.Component, host string) *models.Ingress {
+	for _, ingress := range component.Ingresses {
+		if ingress.Host == host {
+			return ingress
+		}
+	}
+	return nil
+}
+
+// renderOverlay renders the kustomization of an env and the strategic merge
+// patches that adjust the base manifests for it
+func (r *Renderer) renderOverlay(app *models.Application, env string) (map[string]string, error) {
+	manifests := map[string]string{}
+
+	var (
+		patches []string
+		images  []*models.KustomizeImage
+	)
+
+	overlay := findOverlay(app, env)
+	if overlay != nil {
+		for _, componentOverlay := range overlay.Components {
+			component := findComponent(app, componentOverlay.Name)
+			if component == nil {
+				return manifests, fmt.Errorf("overlay %q: no component with service %q", env, componentOverlay.Name)
+			}
+
+			patch, err := r.renderDeploymentPatch(app, component, componentOverlay)
+			if err != nil {
+				return manifests, err
+			}
+			if patch != "" {
+				filename := deploymentName(component.Service)
+				manifests[filename] = patch
+				patches = append(patches, filename)
+			}
+
+			for _, containerOverlay := range componentOverlay.Containers {
+				container := findContainer(component, containerOverlay.Name)
+				if container == nil {
+					return manifests, fmt.Errorf("overlay %q: no container %q in component %q", env, containerOverlay.Name, componentOverlay.Name)
+				}
+				if containerOverlay.ImageTag != "" {
+					images = append(images, &models.KustomizeImage{Name: container.Image, NewTag: containerOverlay.ImageTag})
+				}
+			}
+
+			for _, ingressOverlay := range componentOverlay.Ingresses {
+				ingress := findIngress(component, ingressOverlay.Host)
+				if ingress == nil {
+					return manifests, fmt.Errorf("overlay %q: no ingress for host %q in component %q", env, ingressOverlay.Host, componentOverlay.Name)
+				}
+
+				// ingress rules have no merge key, so the patch replaces them with rules for the new host
+				patch, err := r.renderIngress(app, component.Service, &models.Ingress{Host: ingressOverlay.NewHost, Paths: ingress.Paths})
+				if err != nil {
+					return manifests, err
+				}
+				filename := ingressName(ingress)
+				manifests[filename] = patch
+				patches = append(patches, filename)
+			}
+		}
+	}
+
+	result, err := r.BuildKustomization(app, newOverlayKustomization(patches, images))
+	if err != nil {
+		return manifests, err
+	}
+	manifests[templates["kustomization"][0]] = result
+
+	return manifests, nil
+}
+
+// renderDeploymentPatch renders the replicas and container resources of a
+// component overlay. It returns an empty string when there is nothing to patch.
+func (r *Renderer) renderDeploymentPatch(app *models.Application, component *models.Component, componentOverlay *models.ComponentOverlay) (string, error) {
+	data := struct {
+		App        *models.Application
+		Service    *models.Service
+		Replicas   int64
+		Containers []*models.ContainerOverlay
+	}{
+		App:      app,
+		Service:  component.Service,
+		Replicas: componentOverlay.Replicas,
+	}
+	for _, containerOverlay := range componentOverlay.Containers {
+		if containerOverlay.Resources != nil {
+			data.Containers = append(data.Containers, containerOverlay)
+		}
+	}
+
+	if data.Replicas == 0 && len(data.Containers) == 0 {
+		return "", nil
+	}
+
+	return r.renderResource(app, templates["deploymentpatches"][0], data, func() interface{} {
+		return newTypedDeploymentPatch(component.Service, data.Replicas, data.Containers)
+	})
+}
+
+// RenderResources renders the Kubernetes resources an application deploys to
+// each env it renders an overlay or values for: its base resources with the
+// adjustments of the overlay of the env applied, the way Kustomize applies
+// its patches or Helm the values file of the env. Kustomize resources are
+// also transformed by the kustomize features of the application, like its
+// namespace, name prefix and image overrides. The templates of the chart
+// render the same resources as the base, so the format only changes their
+// keys: the directory of the overlay of the env and the filename of the
+// resource, e.g. overlays/prod/deployment-web.yaml, or the values file of the
+// env instead of the directory for Helm, e.g. values-prod.yaml/deployment-web.yaml.
+func (r *Renderer) RenderResources(app *models.Application) (map[string]string, error) {
+	resources := map[string]string{}
+	helm := Format(app) == models.DestinationFormatHelm
+	for _, env := range overlayEnvs(app) {
+		// kustomize sets the image tags of an overlay with the image transformer
+		// of the overlay, after the transformers of the base
+		envApp, err := envApplication(app, env, helm)
+		if err != nil {
+			return resources, err
+		}
+
+		manifests, err := r.renderBase(envApp)
+		if err != nil {
+			return resources, err
+		}
+		delete(manifests, templates["kustomization"][0])
+
+		if !helm {
+			manifests, err = kustomizeResources(manifests, newBaseKustomization(app, nil), newOverlayKustomization(nil, overlayImages(app, env)))
+			if err != nil {
+				return resources, err
+			}
+		}
+
+		dir := overlayDir(env)
+		if helm {
+			dir = valuesFile
+			if findOverlay(app, env) != nil {
+				dir = valuesFileName(env)
+			}
+		}
+		for filename, content := range manifests {
+			resources[path.Join(dir, filename)] = content
+		}
+	}
+	return resources, nil
+}
+
+// envApplication returns a copy of an application with the replicas,
+// container resources, ingress hosts and, if imageTags, image tags of the
+// overlay of an env applied. Like the patches of the overlay, resources are
+// merged by field.
+func envApplication(app *models.Application, env string, imageTags bool) (*models.Application, error) {
+	b, err := app.MarshalBinary()
+	if err != nil {
+		return nil, errors.Wrap(err, "copying application")
+	}
+	envApp := &models.Application{}
+	if err := envApp.UnmarshalBinary(b); err != nil {
+		return nil, errors.Wrap(err, "copying application")
+	}
+
+	overlay := findOverlay(envApp, env)
+	if overlay == nil {
+		return envApp, nil
+	}
+	for _, componentOverlay := range overlay.Components {
+		component := findComponent(envApp, componentOverlay.Name)
+		if component == nil {
+			return nil, fmt.Errorf("overlay %q: no component with service %q", env, componentOverlay.Name)
+		}
+		if componentOverlay.Replicas != 0 {
+			component.Replicas = componentOverlay.Replicas
+		}
+
+		for _, containerOverlay := range componentOverlay.Containers {
+			container := findContainer(component, containerOverlay.Name)
+			if container == nil {
+				return nil, fmt.Errorf("overlay %q: no container %q in component %q", env, containerOverlay.Name, componentOverlay.Name)
+			}
+			if imageTags && containerOverlay.ImageTag != "" {
+				container.ImageTag = containerOverlay.ImageTag
+			}
+			if containerOverlay.Resources != nil {
+				if container.Resources == nil {
+					container.Resources = &models.ResourceRequirements{}
+				}
+				container.Resources.Requests = mergeResourceList(container.Resources.Requests, containerOverlay.Resources.Requests)
+				container.Resources.Limits = mergeResourceList(container.Resources.Limits, containerOverlay.Resources.Limits)
+			}
+		}
+
+		for _, ingressOverlay := range componentOverlay.Ingresses {
+			ingress := findIngress(component, ingressOverlay.Host)
+			if ingress == nil {
+				return nil, fmt.Errorf("overlay %q: no ingress for host %q in component %q", env, ingressOverlay.Host, componentOverlay.Name)
+			}
+			ingress.Host = ingressOverlay.NewHost
+		}
+	}
+	return envApp, nil
+}
+
+// overlayImages returns the image overrides of the overlay of an env: the
+// image tags of its containers
+func overlayImages(app *models.Application, env string) []*models.KustomizeImage {
+	overlay := findOverlay(app, env)
+	if overlay == nil {
+		return nil
+	}
+
+	var images []*models.KustomizeImage
+	for _, componentOverlay := range overlay.Components {
+		component := findComponent(app, componentOverlay.Name)
+		if component == nil {
+			continue
+		}
+		for _, containerOverlay := range componentOverlay.Containers {
+			if container := findContainer(component, containerOverlay.Name); container != nil && containerOverlay.ImageTag != "" {
+				images = append(images, &models.KustomizeImage{Name: container.Image, NewTag: containerOverlay.ImageTag})
+			}
+		}
+	}
+	return images
+}
+
+// mergeResourceList returns the quantities of a resource list with those an
+// overlay sets replaced
+func mergeResourceList(list, overlay *models.ResourceList) *models.ResourceList {
+	if overlay == nil {
+		return list
+	}
+	merged := &models.ResourceList{}
+	if list != nil {
+		*merged = *list
+	}
+	if overlay.CPU != "" {
+		merged.CPU = overlay.CPU
+	}
+	if overlay.Memory != "" {
+		merged.Memory = overlay.Memory
+	}
+	return merged
+}
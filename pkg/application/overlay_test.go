@@ -0,0 +1,180 @@
+package application_test
+
+import (
+	"strings"
+	"testing"
+
+	"deploy-wizard/gen/models"
+	"deploy-wizard/pkg/application"
+
+	"github.com/andreyvit/diff"
+)
+
+var prodOverlay = &models.Overlay{
+	Env: "Prod",
+	Components: []*models.ComponentOverlay{
+		{
+			Name:     "app1",
+			Replicas: 3,
+			Containers: []*models.ContainerOverlay{
+				{
+					Name:     "app1",
+					ImageTag: "1.19",
+					Resources: &models.ResourceRequirements{
+						Requests: &models.ResourceList{CPU: "500m", Memory: "256Mi"},
+						Limits:   &models.ResourceList{Memory: "512Mi"},
+					},
+				},
+			},
+			Ingresses: []*models.IngressOverlay{
+				{Host: "app1.mc.int", NewHost: "app1.prod.mc.int"},
+			},
+		},
+	},
+}
+
+var expectedProdKustomization = `resources:
+- ../../base
+patches:
+- path: deployment-app1.yaml
+- path: ingress-app1.mc.int.yaml
+images:
+- name: nginx
+	newTag: "1.19"
+`
+
+var expectedProdDeploymentPatch = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+	name: app1
+spec:
+	replicas: 3
+	template:
+		spec:
+			containers:
+			- name: app1
+				resources:
+					requests:
+						cpu: 500m
+						memory: 256Mi
+					limits:
+						memory: 512Mi
+`
+
+func TestRenderManifestsOverlays(t *testing.T) {
+	app := application.ApplyDefaults(validApplication)
+	app.Spec.Overlays = []*models.Overlay{prodOverlay}
+	defer func() { app.Spec.Overlays = nil }()
+
+	renderer, err := application.NewRenderer("../../_templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := renderer.RenderManifests(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result := results["overlays/dev/kustomization.yaml"]; result != "resources:\n- ../../base\n" {
+		t.Errorf("expected the dev overlay to only reference the base, got:\n%s", result)
+	}
+
+	for filename, expected := range map[string]string{
+		"overlays/prod/kustomization.yaml":   expectedProdKustomization,
+		"overlays/prod/deployment-app1.yaml": expectedProdDeploymentPatch,
+	} {
+		expected = strings.Replace(expected, "\t", "  ", -1)
+		if result := results[filename]; result != expected {
+			t.Errorf("%s not as expected:\n%v", filename, diff.LineDiff(result, expected))
+		}
+	}
+
+	ingress, ok := results["overlays/prod/ingress-app1.mc.int.yaml"]
+	if !ok {
+		t.Fatalf("ingress patch not found in %v", results)
+	}
+	if !strings.Contains(ingress, "host: app1.prod.mc.int") || strings.Contains(ingress, "host: app1.mc.int") {
+		t.Errorf("expected the ingress patch to use the new host:\n%s", ingress)
+	}
+}
+
+func TestValidateOverlays(t *testing.T) {
+	app := application.ApplyDefaults(validApplication)
+
+	if errs := application.ValidateOverlays([]*models.Overlay{prodOverlay}, app.Spec.Components); len(errs) > 0 {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+
+	if errs := application.ValidateOverlay(&models.Overlay{Env: "prod"}, app.Spec.Components); len(errs) > 0 {
+		t.Errorf("expected the env of an overlay to match regardless of case, got %v", errs)
+	}
+
+	errs := application.ValidateOverlays([]*models.Overlay{
+		prodOverlay,
+		{
+			Env: "prod",
+			Components: []*models.ComponentOverlay{
+				{Name: "app2"},
+				{
+					Name:       "app1",
+					Replicas:   -1,
+					Containers: []*models.ContainerOverlay{{Name: "app1", ImageTag: "v1:2", Resources: &models.ResourceRequirements{Limits: &models.ResourceList{CPU: "half"}}}},
+				},
+			},
+		},
+	}, app.Spec.Components)
+
+	overlay, _ := errs["1"].(map[string]interface{})
+	if _, ok := overlay["env"]; !ok {
+		t.Errorf("expected a duplicate env error, got %v", errs)
+	}
+	components, _ := overlay["components"].(map[string]interface{})
+	if _, ok := components["0"].(map[string]interface{})["name"]; !ok {
+		t.Errorf("expected an unknown component error, got %v", errs)
+	}
+	component, _ := components["1"].(map[string]interface{})
+	if got := validationError(component, "replicas"); got != "replicas must not be negative" {
+		t.Errorf("expected a replicas error, got %v", errs)
+	}
+	if _, ok := component["containers"]; !ok {
+		t.Errorf("expected a resources error, got %v", errs)
+	}
+	if got := validationError(errs, "1.components.1.containers.0.imageTag"); got == "" {
+		t.Errorf("expected an image tag error, got %v", errs)
+	}
+}
+
+func TestRenderResourcesKustomize(t *testing.T) {
+	app := newDeterminismApplication(models.DestinationFormatKustomize)
+	app.Spec.Kustomize.Namespace = "payments"
+	app.Spec.Kustomize.NamePrefix = "team-"
+	app.Spec.Kustomize.Images = []*models.KustomizeImage{{Name: "nginx", NewName: "registry.mc.int/nginx", Digest: "sha256:4bf5c2b1"}}
+
+	renderer, err := application.NewRenderer("../../_templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resources, err := renderer.RenderResources(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deployment := resources["overlays/dev/deployment-web.yaml"]
+	for _, expected := range []string{
+		"  name: team-web\n",
+		"  namespace: payments\n",
+		"    cost-center: \"42\"\n",
+		"image: registry.mc.int/nginx@sha256:4bf5c2b1\n",
+		"name: team-alpha\n",
+		"claimName: team-logs\n",
+	} {
+		if !strings.Contains(deployment, expected) {
+			t.Errorf("expected the deployment to contain %q, got:\n%s", expected, deployment)
+		}
+	}
+	if ingress := resources["overlays/prod/ingress-web.prod.mc.int.yaml"]; !strings.Contains(ingress, "name: team-web\n") {
+		t.Errorf("expected the ingress to route to the renamed service, got:\n%s", ingress)
+	}
+}
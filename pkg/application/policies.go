@@ -0,0 +1,221 @@
+package application
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"deploy-wizard/gen/models"
+)
+
+const (
+	errMsgPolicyMinReplicas = "policy %q requires at least %d replicas"
+	errMsgPolicyProbe       = "policy %q requires a %s probe"
+	errMsgPolicyRegistry    = "%q is not an image of a registry policy %q allows: %s"
+	errMsgPolicyCapacity    = "policy %q allows a capacity of at most %dGi"
+	errMsgPolicyServiceType = "policy %q forbids services of type %q"
+)
+
+var (
+	probeKinds   = []string{"readiness", "liveness"}
+	serviceTypes = []string{models.ServiceTypeClusterIP, models.ServiceTypeExternalName, models.ServiceTypeLoadBalancer}
+)
+
+// Policy is a profile of rules that corporate security and operations
+// enforce on the applications it selects. A rule that is not set is not
+// enforced.
+type Policy struct {
+	Name string `yaml:"name"`
+
+	// Envs and Teams select the applications by their env and team labels.
+	// A policy without envs or teams selects the applications of any.
+	Envs  []string `yaml:"envs,omitempty"`
+	Teams []string `yaml:"teams,omitempty"`
+
+	// MinReplicas is the number of pods each component runs at least
+	MinReplicas int64 `yaml:"minReplicas,omitempty"`
+	// RequiredProbes are the probes each container defines: readiness or liveness
+	RequiredProbes []string `yaml:"requiredProbes,omitempty"`
+	// AllowedRegistries are the registries, or repositories of a registry,
+	// images are pulled from, e.g. registry.mc.int/team
+	AllowedRegistries []string `yaml:"allowedRegistries,omitempty"`
+	// MaxPersistentVolumeCapacity is the capacity in Gi a PersistentVolume requests at most
+	MaxPersistentVolumeCapacity int64 `yaml:"maxPersistentVolumeCapacity,omitempty"`
+	// ForbiddenServiceTypes are the types of services components can not expose
+	ForbiddenServiceTypes []string `yaml:"forbiddenServiceTypes,omitempty"`
+}
+
+// Policies holds the policy profiles of the server-side configuration
+type Policies []*Policy
+
+// Select returns the policies that select the applications of an env and team
+func (p Policies) Select(labels *models.Labels) Policies {
+	if labels == nil {
+		return nil
+	}
+
+	var selected Policies
+	for _, policy := range p {
+		if len(policy.Envs) > 0 && !containsFold(policy.Envs, labels.Env) {
+			continue
+		}
+		if len(policy.Teams) > 0 && !containsFold(policy.Teams, labels.Team) {
+			continue
+		}
+		selected = append(selected, policy)
+	}
+	return selected
+}
+
+// Validate checks that every policy has a unique name and rules with valid
+// values
+func (p Policies) Validate() error {
+	seen := map[string]struct{}{}
+	for i, policy := range p {
+		if policy.Name == "" {
+			return fmt.Errorf("policy %d: name is required", i)
+		}
+		if _, ok := seen[policy.Name]; ok {
+			return fmt.Errorf("policy %d: duplicate name %q", i, policy.Name)
+		}
+		seen[policy.Name] = struct{}{}
+
+		if policy.MinReplicas < 0 {
+			return fmt.Errorf("policy %q: minReplicas must not be negative", policy.Name)
+		}
+		if policy.MaxPersistentVolumeCapacity < 0 {
+			return fmt.Errorf("policy %q: maxPersistentVolumeCapacity must not be negative", policy.Name)
+		}
+		for _, probe := range policy.RequiredProbes {
+			if !containsString(probeKinds, probe) {
+				return fmt.Errorf("policy %q: required probe %q must be one of %s", policy.Name, probe, strings.Join(probeKinds, ", "))
+			}
+		}
+		for _, serviceType := range policy.ForbiddenServiceTypes {
+			if !containsString(serviceTypes, serviceType) {
+				return fmt.Errorf("policy %q: forbidden service type %q must be one of %s", policy.Name, serviceType, strings.Join(serviceTypes, ", "))
+			}
+		}
+		for _, registry := range policy.AllowedRegistries {
+			if registry == "" || strings.HasSuffix(registry, "/") {
+				return fmt.Errorf("policy %q: allowed registry %q must be a registry or repository without a trailing /", policy.Name, registry)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidatePolicies returns of map with key = field and value = error for the
+// rules of the policies the application breaks. The message of each error
+// names the policy whose rule failed.
+func ValidatePolicies(app *models.Application, policies Policies) map[string]interface{} {
+	errors := map[string]interface{}{}
+	for _, policy := range policies {
+		validatePolicy(app, policy, errors)
+	}
+	return errors
+}
+
+func validatePolicy(app *models.Application, policy *Policy, errors map[string]interface{}) {
+	var overlay *models.Overlay
+	if app.Metadata.Labels != nil {
+		overlay = findOverlay(app, app.Metadata.Labels.Env)
+	}
+	overlayIndex := -1
+	for i := range app.Spec.Overlays {
+		if app.Spec.Overlays[i] == overlay {
+			overlayIndex = i
+		}
+	}
+
+	for i, component := range app.Spec.Components {
+		componentPath := []string{"spec", "components", strconv.Itoa(i)}
+		if component.Service == nil {
+			continue
+		}
+
+		if containsString(policy.ForbiddenServiceTypes, component.Service.Type) {
+			setValidationError(errors, newValidationError(errMsgPolicyServiceType, policy.Name, component.Service.Type), append(componentPath, "service", "type")...)
+		}
+
+		if policy.MinReplicas > 0 {
+			replicas, replicasPath := component.Replicas, append(componentPath, "replicas")
+			if replicas == 0 {
+				replicas = defaultComponentReplicas
+			}
+			if overlay != nil {
+				for j, componentOverlay := range overlay.Components {
+					if componentOverlay.Name == component.Service.Name && componentOverlay.Replicas > 0 {
+						replicas = componentOverlay.Replicas
+						replicasPath = []string{"spec", "overlays", strconv.Itoa(overlayIndex), "components", strconv.Itoa(j), "replicas"}
+					}
+				}
+			}
+			if replicas < policy.MinReplicas {
+				setValidationError(errors, newValidationError(errMsgPolicyMinReplicas, policy.Name, policy.MinReplicas), replicasPath...)
+			}
+		}
+
+		for j, container := range component.Containers {
+			containerPath := append(componentPath, "containers", strconv.Itoa(j))
+
+			if len(policy.AllowedRegistries) > 0 && !isAllowedImage(container.Image, policy.AllowedRegistries) {
+				setValidationError(errors, newValidationError(errMsgPolicyRegistry, container.Image, policy.Name, strings.Join(policy.AllowedRegistries, ", ")), append(containerPath, "image")...)
+			}
+
+			probes := map[string]*models.Probe{"readiness": container.ReadinessProbe, "liveness": container.LivenessProbe}
+			for _, kind := range policy.RequiredProbes {
+				if probes[kind] == nil {
+					setValidationError(errors, newValidationError(errMsgPolicyProbe, policy.Name, kind), append(containerPath, kind+"Probe")...)
+				}
+			}
+		}
+	}
+
+	if len(policy.AllowedRegistries) > 0 && app.Spec.Kustomize != nil {
+		for i, image := range app.Spec.Kustomize.Images {
+			if image.NewName != "" && !isAllowedImage(image.NewName, policy.AllowedRegistries) {
+				setValidationError(errors, newValidationError(errMsgPolicyRegistry, image.NewName, policy.Name, strings.Join(policy.AllowedRegistries, ", ")),
+					"spec", "kustomize", "images", strconv.Itoa(i), "newName")
+			}
+		}
+	}
+
+	if policy.MaxPersistentVolumeCapacity > 0 {
+		for i, persistentVolume := range app.Spec.PersistentVolumes {
+			if persistentVolume.Capacity > policy.MaxPersistentVolumeCapacity {
+				setValidationError(errors, newValidationError(errMsgPolicyCapacity, policy.Name, policy.MaxPersistentVolumeCapacity),
+					"spec", "persistentVolumes", strconv.Itoa(i), "capacity")
+			}
+		}
+	}
+}
+
+// isAllowedImage returns whether an image is pulled from one of the
+// registries, or repositories of a registry. Images without a registry are
+// pulled from Docker Hub, e.g. nginx is docker.io/library/nginx.
+func isAllowedImage(image string, registries []string) bool {
+	parts := strings.SplitN(image, "/", 2)
+	switch {
+	case len(parts) == 1:
+		image = "docker.io/library/" + image
+	case !strings.ContainsAny(parts[0], ".:") && parts[0] != "localhost":
+		image = "docker.io/" + image
+	}
+
+	for _, registry := range registries {
+		if image == registry || strings.HasPrefix(image, registry+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
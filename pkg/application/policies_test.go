@@ -0,0 +1,156 @@
+package application_test
+
+import (
+	"strings"
+	"testing"
+
+	"deploy-wizard/gen/models"
+	"deploy-wizard/pkg/application"
+)
+
+func TestPoliciesSelect(t *testing.T) {
+	policies := application.Policies{
+		{Name: "everyone"},
+		{Name: "prod", Envs: []string{"Prod"}},
+		{Name: "payments", Teams: []string{"payments"}},
+		{Name: "payments-prod", Envs: []string{"prod"}, Teams: []string{"payments"}},
+	}
+
+	tests := []struct {
+		labels   *models.Labels
+		expected []string
+	}{
+		{&models.Labels{Env: "Dev", Team: "tenant1"}, []string{"everyone"}},
+		{&models.Labels{Env: "Prod", Team: "tenant1"}, []string{"everyone", "prod"}},
+		{&models.Labels{Env: "Dev", Team: "payments"}, []string{"everyone", "payments"}},
+		{&models.Labels{Env: "Prod", Team: "payments"}, []string{"everyone", "prod", "payments", "payments-prod"}},
+		{nil, nil},
+	}
+	for _, test := range tests {
+		var names []string
+		for _, policy := range policies.Select(test.labels) {
+			names = append(names, policy.Name)
+		}
+		if strings.Join(names, ",") != strings.Join(test.expected, ",") {
+			t.Errorf("%+v: expected %v, got %v", test.labels, test.expected, names)
+		}
+	}
+}
+
+func TestPoliciesValidate(t *testing.T) {
+	invalid := map[string]application.Policies{
+		"no name":              {{MinReplicas: 2}},
+		"duplicate name":       {{Name: "prod"}, {Name: "prod"}},
+		"negative replicas":    {{Name: "prod", MinReplicas: -1}},
+		"negative capacity":    {{Name: "prod", MaxPersistentVolumeCapacity: -1}},
+		"unknown probe":        {{Name: "prod", RequiredProbes: []string{"startup"}}},
+		"unknown service type": {{Name: "prod", ForbiddenServiceTypes: []string{"NodePort"}}},
+		"registry with slash":  {{Name: "prod", AllowedRegistries: []string{"registry.mc.int/"}}},
+	}
+	for name, policies := range invalid {
+		if err := policies.Validate(); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	valid := application.Policies{{
+		Name:                        "prod",
+		Envs:                        []string{"Prod"},
+		MinReplicas:                 2,
+		RequiredProbes:              []string{"readiness", "liveness"},
+		AllowedRegistries:           []string{"registry.mc.int", "docker.mc.int/base"},
+		MaxPersistentVolumeCapacity: 100,
+		ForbiddenServiceTypes:       []string{models.ServiceTypeLoadBalancer},
+	}}
+	if err := valid.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestValidatePolicies(t *testing.T) {
+	policies := application.Policies{
+		{Name: "replicas", MinReplicas: 2},
+		{Name: "probes", RequiredProbes: []string{"readiness"}},
+		{Name: "registries", AllowedRegistries: []string{"registry.mc.int", "docker.mc.int/base"}},
+		{Name: "storage", MaxPersistentVolumeCapacity: 10},
+		{Name: "services", ForbiddenServiceTypes: []string{models.ServiceTypeLoadBalancer}},
+		{Name: "other-team", Teams: []string{"payments"}, MinReplicas: 5},
+	}
+
+	app := newDeterminismApplication(models.DestinationFormatKustomize)
+	app.Spec.Overlays = append(app.Spec.Overlays, &models.Overlay{
+		Env: "Dev", Components: []*models.ComponentOverlay{{Name: "api", Replicas: 2}},
+	})
+	app.Spec.Components[0].Replicas = 3
+	app.Spec.Components[2].Replicas = 2
+	app.Spec.Components[0].Containers[0].Image = "registry.mc.int/tenant1/web"
+	app.Spec.Components[1].Containers[0].Image = "docker.mc.int/base/api"
+	app.Spec.Components[2].Containers[0].Image = "docker.mc.int/other/worker"
+	app.Spec.Components[0].Service.Type = models.ServiceTypeLoadBalancer
+	app.Spec.PersistentVolumes[1].Capacity = 20
+	for _, component := range app.Spec.Components[1:] {
+		component.Containers[0].ReadinessProbe = &models.Probe{PortName: "http"}
+	}
+
+	errs := application.ValidateApplication(app, application.EnforcePolicies(policies))
+
+	expected := map[string]string{
+		"spec.components.0.service.type":                `policy "services" forbids services of type "LoadBalancer"`,
+		"spec.components.0.containers.0.readinessProbe": `policy "probes" requires a readiness probe`,
+		"spec.components.2.containers.0.image":          `"docker.mc.int/other/worker" is not an image of a registry policy "registries" allows: registry.mc.int, docker.mc.int/base`,
+		"spec.persistentVolumes.1.capacity":             `policy "storage" allows a capacity of at most 10Gi`,
+	}
+	for field, message := range expected {
+		if got := validationError(errs, field); got != message {
+			t.Errorf("expected %s to be %q, got %q", field, message, got)
+		}
+	}
+	if issues := application.Issues(errs, models.ValidationIssueSeverityError); len(issues) != len(expected) {
+		t.Errorf("expected %d errors, got %s", len(expected), toJSON(t, issues))
+	}
+
+	app.Spec.Overlays[1].Components[0].Replicas = 1
+	errs = application.ValidateApplication(app, application.EnforcePolicies(policies))
+	if got := validationError(errs, "spec.overlays.1.components.0.replicas"); got != `policy "replicas" requires at least 2 replicas` {
+		t.Errorf("expected the replicas of the overlay of the env to break the policy, got %v", errs)
+	}
+}
+
+func TestValidatePoliciesDockerHub(t *testing.T) {
+	app := newDeterminismApplication(models.DestinationFormatKustomize)
+	app.Spec.Components[1].Containers[0].Image = "bitnami/redis"
+	app.Spec.Components[2].Containers[0].Image = "docker.io/bitnami/redis"
+
+	errs := application.ValidateApplication(app, application.EnforcePolicies(application.Policies{
+		{Name: "hub", AllowedRegistries: []string{"docker.io/library"}},
+	}))
+	if got := validationError(errs, "spec.components.0.containers.0.image"); got != "" {
+		t.Errorf("expected nginx to be an image of docker.io/library, got %q", got)
+	}
+	for _, field := range []string{"spec.components.1.containers.0.image", "spec.components.2.containers.0.image"} {
+		if got := validationError(errs, field); !strings.Contains(got, `policy "hub"`) {
+			t.Errorf("expected %s to break the policy, got %v", field, errs)
+		}
+	}
+}
+
+func TestValidatePoliciesKeepsFieldErrors(t *testing.T) {
+	app := newDeterminismApplication(models.DestinationFormatKustomize)
+	app.Spec.Components[0].Containers[0].Image = "Nginx"
+
+	errs := application.ValidateApplication(app, application.EnforcePolicies(application.Policies{
+		{Name: "registries", AllowedRegistries: []string{"registry.mc.int"}},
+	}))
+	if got := validationError(errs, "spec.components.0.containers.0.image"); !strings.Contains(got, "must be an image reference") {
+		t.Errorf("expected the format error of the image, got %v", errs)
+	}
+
+	issues := application.Issues(errs, models.ValidationIssueSeverityError)
+	var codes []string
+	for _, issue := range issues {
+		codes = append(codes, issue.Code)
+	}
+	if strings.Count(strings.Join(codes, ","), "policy-allowed-registries") != 2 {
+		t.Errorf("expected the other containers to break the policy, got %s", toJSON(t, issues))
+	}
+}
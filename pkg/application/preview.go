@@ -0,0 +1,195 @@
+package application
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"deploy-wizard/gen/models"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// previewResource holds the fields of a rendered resource the preview checks
+type previewResource struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name string `yaml:"name"`
+	} `yaml:"metadata"`
+	Spec struct {
+		Template struct {
+			Spec struct {
+				Containers []struct {
+					Name      string                 `yaml:"name"`
+					Image     string                 `yaml:"image"`
+					Resources map[string]interface{} `yaml:"resources"`
+				} `yaml:"containers"`
+			} `yaml:"spec"`
+		} `yaml:"template"`
+	} `yaml:"spec"`
+}
+
+// RenderPreview renders the files a release of the application commits, each
+// with the kind of its resources, the warnings found in it and the schema
+// errors that would block the release. Like a release, it expects an
+// application CheckApplication found no errors in.
+func (r *Renderer) RenderPreview(app *models.Application) (*models.PreviewResponse, error) {
+	manifests, err := r.RenderManifests(app)
+	if err != nil {
+		return nil, err
+	}
+
+	deploySpecs, err := r.RenderDeploySpecs(app, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	problems, err := r.ValidateManifests(app, manifests)
+	if err != nil {
+		return nil, err
+	}
+	if err := AddReleaseRecord(app, manifests); err != nil {
+		return nil, err
+	}
+
+	files := newPreviewFiles(manifests)
+	var filenames []string
+	for filename := range problems {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	errs := map[string][]string{}
+	for _, filename := range filenames {
+		fileProblems := problems[filename]
+		if _, ok := manifests[filename]; ok {
+			errs[filename] = append(errs[filename], fileProblems...)
+			continue
+		}
+		// the problems of the resources a chart renders with a values file
+		// are reported on that file
+		for _, problem := range fileProblems {
+			valuesFile := path.Dir(filename)
+			errs[valuesFile] = append(errs[valuesFile], fmt.Sprintf("%s: %s", path.Base(filename), problem))
+		}
+	}
+	for _, file := range files {
+		file.Errors = errs[file.Name]
+	}
+
+	return &models.PreviewResponse{
+		Files:       files,
+		DeploySpecs: newPreviewFiles(deploySpecs),
+	}, nil
+}
+
+func newPreviewFiles(files map[string]string) []*models.PreviewFile {
+	var filenames []string
+	for filename := range files {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	previewFiles := make([]*models.PreviewFile, 0, len(filenames))
+	for _, filename := range filenames {
+		previewFiles = append(previewFiles, newPreviewFile(filename, files[filename]))
+	}
+	return previewFiles
+}
+
+func newPreviewFile(filename, content string) *models.PreviewFile {
+	previewFile := &models.PreviewFile{Name: filename, Content: content}
+
+	switch base := path.Base(filename); {
+	case base == templates["kustomization"][0]:
+		previewFile.Kind = "Kustomization"
+		previewFile.Warnings = checkYAML(content)
+		return previewFile
+	case base == chartFile:
+		previewFile.Kind = "Chart"
+		previewFile.Warnings = checkYAML(content)
+		return previewFile
+	case strings.HasPrefix(filename, "templates/"):
+		// chart templates are only valid YAML once Helm renders them
+		previewFile.Kind = "Template"
+		return previewFile
+	case strings.HasPrefix(base, "values"):
+		previewFile.Kind = "Values"
+		previewFile.Warnings = checkYAML(content)
+		return previewFile
+	case filename == SpecFile:
+		previewFile.Kind = "Spec"
+		return previewFile
+	case filename == FilesFile:
+		previewFile.Kind = "Record"
+		return previewFile
+	}
+
+	resources, err := decodePreviewResources(content)
+	if err != nil {
+		previewFile.Warnings = []string{fmt.Sprintf("the file is not valid YAML: %s", err)}
+		return previewFile
+	}
+
+	var kinds []string
+	for _, resource := range resources {
+		if resource.Kind != "" && !containsString(kinds, resource.Kind) {
+			kinds = append(kinds, resource.Kind)
+		}
+		previewFile.Warnings = append(previewFile.Warnings, checkResource(resource)...)
+	}
+	previewFile.Kind = strings.Join(kinds, ", ")
+
+	return previewFile
+}
+
+func decodePreviewResources(content string) ([]*previewResource, error) {
+	var resources []*previewResource
+
+	decoder := yaml.NewDecoder(strings.NewReader(content))
+	for {
+		resource := &previewResource{}
+		err := decoder.Decode(resource)
+		if err == io.EOF {
+			return resources, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, resource)
+	}
+}
+
+func checkYAML(content string) []string {
+	var out interface{}
+	if err := yaml.Unmarshal([]byte(content), &out); err != nil {
+		return []string{fmt.Sprintf("the file is not valid YAML: %s", err)}
+	}
+	return nil
+}
+
+// checkResource returns the warnings found in a resource
+func checkResource(resource *previewResource) []string {
+	var warnings []string
+
+	if resource.APIVersion == "" || resource.Kind == "" {
+		warnings = append(warnings, "the resource has no apiVersion or kind")
+	}
+	if resource.Metadata.Name == "" {
+		warnings = append(warnings, fmt.Sprintf("the %s has no name", resource.Kind))
+	}
+
+	for _, container := range resource.Spec.Template.Spec.Containers {
+		if len(container.Resources) == 0 {
+			warnings = append(warnings, fmt.Sprintf("container %q has no resource requests or limits", container.Name))
+		}
+		if strings.HasSuffix(container.Image, ":") || strings.HasSuffix(container.Image, ":latest") {
+			warnings = append(warnings, fmt.Sprintf("container %q does not pin its image tag", container.Name))
+		}
+	}
+
+	return warnings
+}
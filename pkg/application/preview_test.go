@@ -0,0 +1,74 @@
+package application_test
+
+import (
+	"testing"
+
+	"deploy-wizard/gen/models"
+	"deploy-wizard/pkg/application"
+)
+
+func TestRenderPreview(t *testing.T) {
+	app := application.ApplyDefaults(validApplication)
+
+	renderer, err := application.NewRenderer("../../_templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	preview, err := renderer.RenderPreview(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := map[string]*models.PreviewFile{}
+	for _, file := range preview.Files {
+		files[file.Name] = file
+	}
+
+	for name, kind := range map[string]string{
+		"base/deployment-app1.yaml":       "Deployment",
+		"base/service-app1.yaml":          "Service",
+		"base/kustomization.yaml":         "Kustomization",
+		"overlays/dev/kustomization.yaml": "Kustomization",
+		"application.json":                "Spec",
+		"files.json":                      "Record",
+	} {
+		file, ok := files[name]
+		if !ok {
+			t.Errorf("%s not found", name)
+			continue
+		}
+		if file.Kind != kind {
+			t.Errorf("%s: expected kind %q, got %q", name, kind, file.Kind)
+		}
+		if file.Content == "" {
+			t.Errorf("%s: expected content", name)
+		}
+	}
+
+	warnings := files["base/deployment-app1.yaml"].Warnings
+	if len(warnings) != 1 || warnings[0] != `container "app1" has no resource requests or limits` {
+		t.Errorf("expected a resources warning, got %v", warnings)
+	}
+	if warnings := files["base/service-app1.yaml"].Warnings; len(warnings) > 0 {
+		t.Errorf("expected no warnings, got %v", warnings)
+	}
+
+	if len(preview.DeploySpecs) != 2 || preview.DeploySpecs[0].Name != "apps/app1.yaml" || preview.DeploySpecs[0].Kind != "Application" {
+		t.Errorf("expected the ArgoCD Application and AppProject, got %+v", preview.DeploySpecs)
+	}
+}
+
+func TestCheckApplicationBeforePreview(t *testing.T) {
+	app := newDeterminismApplication(models.DestinationFormatKustomize)
+	app.Spec.Components[0].Ingresses[0].Paths = nil
+	app.Metadata.Labels.Region = "BEL"
+
+	// the preview handlers render only an application without errors
+	errs := application.CheckApplication(app, application.RequireCluster(testClusters)).Errors
+	for _, field := range []string{"spec.components.0.ingresses.0.paths", "metadata.labels.region"} {
+		if message := validationError(errs, field); message == "" {
+			t.Errorf("expected a %s error, got %v", field, errs)
+		}
+	}
+}
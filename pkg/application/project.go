@@ -0,0 +1,109 @@
+package application
+
+import (
+	"sort"
+	"strings"
+
+	"deploy-wizard/gen/models"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const projectTemplate = "argocd-appproject.yaml"
+
+// appProject holds the fields of a committed AppProject that are merged
+// when a team releases another application
+type appProject struct {
+	Spec struct {
+		SourceRepos  []string              `yaml:"sourceRepos"`
+		Destinations []*projectDestination `yaml:"destinations"`
+	} `yaml:"spec"`
+}
+
+type projectDestination struct {
+	Namespace string `yaml:"namespace"`
+	Server    string `yaml:"server,omitempty"`
+	Name      string `yaml:"name,omitempty"`
+}
+
+// ProjectName returns the name of the ArgoCD AppProject of the application's team
+func ProjectName(app *models.Application) string {
+	return strings.ToLower(app.Metadata.Labels.Team)
+}
+
+// applicationProject returns the ArgoCD project of an application deployed to
+// a cluster: the project of the cluster if set, else the AppProject of the
+// application's team
+func applicationProject(app *models.Application, cluster *Cluster) string {
+	if cluster.Project != "" {
+		return cluster.Project
+	}
+	return ProjectName(app)
+}
+
+// RenderProjectSpec renders the ArgoCD AppProject of the application's team.
+// current is the AppProject already committed for the team, if any. Its
+// source repos and destinations are kept so that releasing one application
+// does not lock the other applications of the team out of the project.
+func (r *Renderer) RenderProjectSpec(app *models.Application, current string) (string, error) {
+	cluster, err := r.clusters.Lookup(app.Metadata.Labels.Region, app.Metadata.Labels.Env)
+	if err != nil {
+		return "", err
+	}
+
+	var project appProject
+	if err := yaml.Unmarshal([]byte(current), &project); err != nil {
+		return "", errors.Wrapf(err, "failed to parse the current AppProject %q", ProjectName(app))
+	}
+
+	sourceRepos := map[string]struct{}{app.Spec.Destination.URL.String(): {}}
+	for _, repo := range project.Spec.SourceRepos {
+		sourceRepos[repo] = struct{}{}
+	}
+
+	destinations := map[projectDestination]struct{}{
+		{Namespace: app.Metadata.Namespace, Server: cluster.Server, Name: cluster.Name}: {},
+	}
+	for _, dest := range project.Spec.Destinations {
+		if dest != nil {
+			destinations[*dest] = struct{}{}
+		}
+	}
+
+	data := struct {
+		App          *models.Application
+		Project      string
+		SourceRepos  []string
+		Destinations []projectDestination
+	}{
+		App:          app,
+		Project:      ProjectName(app),
+		SourceRepos:  mapKeys(sourceRepos),
+		Destinations: sortedDestinations(destinations),
+	}
+
+	t, err := r.lookupTemplate(app, projectTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	log.Infof("rendering %q", t.Name())
+	return renderTemplate(t, data)
+}
+
+func sortedDestinations(m map[projectDestination]struct{}) []projectDestination {
+	destinations := make([]projectDestination, 0, len(m))
+	for dest := range m {
+		destinations = append(destinations, dest)
+	}
+	sort.Slice(destinations, func(i, j int) bool {
+		a, b := destinations[i], destinations[j]
+		if a.Server+a.Name != b.Server+b.Name {
+			return a.Server+a.Name < b.Server+b.Name
+		}
+		return a.Namespace < b.Namespace
+	})
+	return destinations
+}
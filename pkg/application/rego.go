@@ -0,0 +1,63 @@
+package application
+
+import (
+	"context"
+
+	"deploy-wizard/gen/models"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errMsgRegoDeny  = "policy %q denies %q: %s"
+	warnMsgRegoWarn = "policy %q warns about %q: %s"
+)
+
+// EvaluatePolicies evaluates the Rego policies of the renderer against the
+// resources the application deploys to each env, as RenderResources renders
+// them with the adjustments of the overlay of the env, and against its deploy
+// specs, like its ArgoCD Application. The messages of their deny rules are
+// added to the errors of the findings and those of their warn rules to the
+// warnings, by file under manifests or deploySpecs.
+func (r *Renderer) EvaluatePolicies(ctx context.Context, app *models.Application, findings *Findings) error {
+	if r.policies == nil {
+		return nil
+	}
+
+	deploySpecs, err := r.RenderDeploySpecs(app, nil)
+	if err != nil {
+		return err
+	}
+
+	resources, err := r.RenderResources(app)
+	if err != nil {
+		return err
+	}
+
+	for key, files := range map[string]map[string]string{"manifests": resources, "deploySpecs": deploySpecs} {
+		for filename, content := range files {
+			results, err := r.policies.Evaluate(ctx, content)
+			if err != nil {
+				return errors.Wrapf(err, "evaluating the policies against %q", filename)
+			}
+			for _, result := range results.Deny {
+				addFileMessage(findings.Errors, key, filename, newValidationError(errMsgRegoDeny, result.Policy, result.Resource, result.Message))
+			}
+			for _, result := range results.Warn {
+				addFileMessage(findings.Warnings, key, filename, newValidationError(warnMsgRegoWarn, result.Policy, result.Resource, result.Message))
+			}
+		}
+	}
+	return nil
+}
+
+// addFileMessage appends a message to the messages of a file under key
+func addFileMessage(messages map[string]interface{}, key, filename string, message validationError) {
+	files, ok := messages[key].(map[string]interface{})
+	if !ok {
+		files = map[string]interface{}{}
+		messages[key] = files
+	}
+	fileMessages, _ := files[filename].([]interface{})
+	files[filename] = append(fileMessages, message)
+}
@@ -0,0 +1,162 @@
+package application_test
+
+import (
+	"context"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+
+	"deploy-wizard/gen/models"
+	"deploy-wizard/pkg/application"
+	"deploy-wizard/pkg/policy"
+)
+
+const regoPolicies = `package kubernetes.workloads
+
+deny[msg] {
+	input.kind == "Deployment"
+	input.metadata.name == "worker"
+	msg := "workers run as jobs"
+}
+
+deny[msg] {
+	input.kind == "Deployment"
+	input.spec.replicas > 2
+	msg := "at most 2 replicas"
+}
+
+deny[msg] {
+	input.kind == "Deployment"
+	container := input.spec.template.spec.containers[_]
+	endswith(container.image, ":latest")
+	msg := sprintf("container %s runs latest", [container.name])
+}
+
+warn[msg] {
+	input.kind == "AppProject"
+	count(input.spec.destinations) == 1
+	msg := "the project has a single destination"
+}
+`
+
+func newPolicyRenderer(t *testing.T) *application.Renderer {
+	engine, err := policy.Compile(map[string]string{"workloads.rego": regoPolicies})
+	if err != nil {
+		t.Fatal(err)
+	}
+	renderer, err := application.NewRenderer("", application.WithPolicies(engine))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return renderer
+}
+
+// evaluatePolicies evaluates the policies of a renderer against an
+// application and returns the reasons of the denied resources by the path of
+// their file and the paths of the warnings
+func evaluatePolicies(t *testing.T, renderer *application.Renderer, app *models.Application) (map[string][]string, []string) {
+	findings := application.CheckApplication(app)
+	if err := renderer.EvaluatePolicies(context.Background(), app, findings); err != nil {
+		t.Fatal(err)
+	}
+
+	response := application.NewFindingsResponse(findings)
+	denied := map[string][]string{}
+	var warned []string
+	for _, issue := range response.Issues {
+		switch issue.Code {
+		case "rego-deny":
+			if issue.Params["policy"] != "kubernetes.workloads" || !strings.HasPrefix(issue.Params["resource"], "Deployment/") {
+				t.Errorf("unexpected parameters %v", issue.Params)
+			}
+			file := path.Dir(issue.Path)
+			denied[file] = append(denied[file], issue.Params["reason"])
+		case "rego-warn":
+			warned = append(warned, issue.Path)
+			if issue.Severity != models.ValidationIssueSeverityWarning {
+				t.Errorf("expected a warning, got %s", toJSON(t, issue))
+			}
+		}
+	}
+	return denied, warned
+}
+
+func TestEvaluatePolicies(t *testing.T) {
+	renderer := newPolicyRenderer(t)
+	app := newDeterminismApplication(models.DestinationFormatKustomize)
+	app.Spec.Overlays[0].Components[1].Containers = []*models.ContainerOverlay{{Name: "web", ImageTag: "latest"}}
+
+	denied, warned := evaluatePolicies(t, renderer, app)
+
+	// the resources of each env are evaluated with the patches of its overlay.
+	// Kustomize overrides images by name, so the tag of web applies to every
+	// nginx container of the env.
+	expected := map[string][]string{
+		"/manifests/overlays~1dev~1deployment-worker.yaml":  {"workers run as jobs"},
+		"/manifests/overlays~1prod~1deployment-api.yaml":    {"container api runs latest"},
+		"/manifests/overlays~1prod~1deployment-worker.yaml": {"container worker runs latest", "workers run as jobs"},
+		"/manifests/overlays~1prod~1deployment-web.yaml":    {"at most 2 replicas", "container web runs latest"},
+	}
+	if !reflect.DeepEqual(denied, expected) {
+		t.Errorf("expected %v, got %v", expected, denied)
+	}
+	if len(warned) != 1 || !strings.HasPrefix(warned[0], "/deploySpecs/projects~1") {
+		t.Errorf("expected a warning about the ArgoCD AppProject, got %v", warned)
+	}
+}
+
+func TestEvaluatePoliciesKustomizeImages(t *testing.T) {
+	renderer := newPolicyRenderer(t)
+	app := newDeterminismApplication(models.DestinationFormatKustomize)
+	app.Spec.Overlays = nil
+	app.Spec.Components = app.Spec.Components[:1]
+	app.Spec.Kustomize.NamePrefix = "team-"
+	app.Spec.Kustomize.Images = []*models.KustomizeImage{{Name: "nginx", NewName: "registry.mc.int/nginx", NewTag: "latest"}}
+
+	denied, _ := evaluatePolicies(t, renderer, app)
+
+	// the policies see the image kustomize deploys, not the one of the container
+	expected := map[string][]string{
+		"/manifests/overlays~1dev~1deployment-web.yaml": {"container web runs latest"},
+	}
+	if !reflect.DeepEqual(denied, expected) {
+		t.Errorf("expected %v, got %v", expected, denied)
+	}
+}
+
+func TestEvaluatePoliciesWithoutPolicies(t *testing.T) {
+	renderer, err := application.NewRenderer("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	app := newDeterminismApplication(models.DestinationFormatKustomize)
+
+	findings := application.CheckApplication(app)
+	if err := renderer.EvaluatePolicies(context.Background(), app, findings); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := findings.Errors["manifests"]; ok {
+		t.Errorf("expected no policy errors, got %v", findings.Errors)
+	}
+}
+
+func TestEvaluatePoliciesHelm(t *testing.T) {
+	renderer := newPolicyRenderer(t)
+	app := newDeterminismApplication(models.DestinationFormatHelm)
+
+	denied, warned := evaluatePolicies(t, renderer, app)
+
+	// the chart is evaluated with the values of each env
+	expected := map[string][]string{
+		"/manifests/values.yaml~1deployment-worker.yaml":      {"workers run as jobs"},
+		"/manifests/values-prod.yaml~1deployment-worker.yaml": {"workers run as jobs"},
+		"/manifests/values-prod.yaml~1deployment-web.yaml":    {"at most 2 replicas"},
+	}
+	if !reflect.DeepEqual(denied, expected) {
+		t.Errorf("expected %v, got %v", expected, denied)
+	}
+	if len(warned) != 1 {
+		t.Errorf("expected the deploy specs to be evaluated, got %v", warned)
+	}
+}
@@ -3,13 +3,16 @@ package application
 import (
 	"bytes"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path"
+	"sort"
 	"strings"
+	"sync"
 	"text/template"
 
 	"deploy-wizard/gen/models"
+	"deploy-wizard/pkg/policy"
+	"deploy-wizard/pkg/schema"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -23,58 +26,179 @@ var templates = map[string][]string{
 	"deployment":        {"deployment.yaml"},
 	"ingresses":         {"ingress.yaml"},
 	"kustomization":     {"kustomization.yaml"},
+	"deploymentpatches": {"deployment-patch.yaml"},
 }
 
+// applicationTemplate is the template of the ArgoCD Application
+const applicationTemplate = "argocd-application.yaml"
+
 var errTemplateUnreadableFormat = "the %q template must exist and be readable"
 
 // Renderer is responsible for rendering manifests
 type Renderer struct {
-	templateDir string
+	templateDir     string
+	teamTemplateDir string
+	clusters        Clusters
+	environments    Environments
+	validators      map[string]*schema.Validator
+	policies        *policy.Engine
+
+	kubernetesVersion string
+	renderMode        RenderMode
+
+	mu    sync.RWMutex
+	cache *templateCache
 }
 
-// NewRenderer creates a new Renderer with the specified options
-func NewRenderer(templateDir string) (*Renderer, error) {
-	log.Infof("creating renderer with template directory %q", templateDir)
-	if _, err := os.Stat(templateDir); os.IsNotExist(err) {
-		return nil, errors.Wrapf(err, "template directory %q does not exist", templateDir)
+// RendererOption configures a Renderer
+type RendererOption func(*Renderer)
+
+// WithClusters sets the clusters applications are deployed to by region and env
+func WithClusters(clusters Clusters) RendererOption {
+	return func(r *Renderer) {
+		r.clusters = clusters
 	}
+}
 
-	// TODO: error if required templates do not exist?
+// WithTeamTemplates sets the directory with a directory of templates per
+// team, named after the team label. Any template in it replaces the embedded
+// and override ones for the applications of that team.
+func WithTeamTemplates(dir string) RendererOption {
+	return func(r *Renderer) {
+		r.teamTemplateDir = dir
+	}
+}
 
-	return &Renderer{templateDir}, nil
+// WithKubernetesVersion sets the Kubernetes version of the applications whose
+// cluster and env do not set one. The manifests use the API versions it serves
+// and are validated against its schemas.
+func WithKubernetesVersion(version string) RendererOption {
+	return func(r *Renderer) {
+		r.kubernetesVersion = version
+	}
 }
 
-// BuildKustomization builds a kustomization file
-func (r *Renderer) BuildKustomization(resources []string) (string, error) {
-	data := struct {
-		Resources []string
-	}{Resources: resources}
+// WithRenderMode sets how the manifests of the core kinds are rendered.
+// Defaults to RenderModeTemplate.
+func WithRenderMode(mode RenderMode) RendererOption {
+	return func(r *Renderer) {
+		r.renderMode = mode
+	}
+}
 
-	templateFile, err := templateFile(r.templateDir, templates["kustomization"][0])
-	if err != nil {
-		return "", errors.Wrapf(err, errTemplateUnreadableFormat)
+// WithEnvironments sets the server-side configuration of each env
+func WithEnvironments(environments Environments) RendererOption {
+	return func(r *Renderer) {
+		r.environments = environments
+	}
+}
+
+// WithPolicies sets the Rego policies EvaluatePolicies evaluates against the
+// rendered manifests and deploy specs
+func WithPolicies(policies *policy.Engine) RendererOption {
+	return func(r *Renderer) {
+		r.policies = policies
+	}
+}
+
+// NewRenderer creates a new Renderer with the specified options. The
+// templates compiled into the server are used unless templateDir, if set,
+// holds a template of the same name.
+func NewRenderer(templateDir string, opts ...RendererOption) (*Renderer, error) {
+	r := &Renderer{templateDir: templateDir}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	for _, dir := range []string{r.templateDir, r.teamTemplateDir} {
+		if dir == "" {
+			continue
+		}
+		log.Infof("creating renderer with template directory %q", dir)
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			return nil, errors.Wrapf(err, "template directory %q does not exist", dir)
+		}
 	}
 
-	result, err := renderTemplate(templateFile, data)
+	cache, err := loadTemplates(r.templateDir, r.teamTemplateDir)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	r.cache = cache
+
+	if err := r.clusters.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid cluster configuration")
+	}
+
+	if err := r.environments.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid environment configuration")
+	}
+
+	if err := r.clusters.ValidateDeployTargets(r.environments); err != nil {
+		return nil, errors.Wrap(err, "invalid cluster configuration")
+	}
+
+	if r.renderMode == "" {
+		r.renderMode = RenderModeTemplate
+	}
+	if !r.renderMode.valid() {
+		return nil, errors.Errorf("unknown render mode %q", r.renderMode)
 	}
 
-	return result, nil
+	if r.kubernetesVersion == "" {
+		r.kubernetesVersion = schema.DefaultVersion
+	}
+	if err := r.loadValidators(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
 }
 
-// RenderManifests renders an application to individual Kubernetes manifest files
+// RenderManifests renders an application to individual Kubernetes manifest
+// files. The manifests and their kustomization are rendered to the base
+// directory, and the adjustments of each environment to overlay directories.
+// Applications in the helm format are rendered to the files of a Helm chart.
 func (r *Renderer) RenderManifests(app *models.Application) (map[string]string, error) {
+	if Format(app) == models.DestinationFormatHelm {
+		return r.RenderChart(app)
+	}
+
+	manifests := map[string]string{}
+
+	baseManifests, err := r.renderBase(app)
+	if err != nil {
+		return manifests, err
+	}
+	for filename, content := range baseManifests {
+		manifests[path.Join(baseDir, filename)] = content
+	}
+
+	for _, env := range overlayEnvs(app) {
+		overlayManifests, err := r.renderOverlay(app, env)
+		if err != nil {
+			return manifests, err
+		}
+		for filename, content := range overlayManifests {
+			manifests[path.Join(overlayDir(env), filename)] = content
+		}
+	}
+
+	return manifests, nil
+}
+
+// renderBase renders the base manifests and their kustomization
+func (r *Renderer) renderBase(app *models.Application) (map[string]string, error) {
 	manifests := map[string]string{}
 
 	for _, tmpl := range templates["app"] {
-		templateFile, err := templateFile(r.templateDir, tmpl)
+		t, err := r.lookupTemplate(app, tmpl)
 		if err != nil {
-			return manifests, errors.Wrapf(err, errTemplateUnreadableFormat)
+			return manifests, err
 		}
 
-		log.Infof("rendering %q", templateFile)
-		result, err := renderTemplate(templateFile, app)
+		log.Infof("rendering %q", t.Name())
+		result, err := renderTemplate(t, app)
 		if err != nil {
 			return manifests, err
 		}
@@ -127,7 +251,7 @@ func (r *Renderer) RenderManifests(app *models.Application) (map[string]string,
 	}
 
 	log.Infof("manifest files: %v", resources)
-	kustomizeFile, err := r.BuildKustomization(resources)
+	kustomizeFile, err := r.BuildKustomization(app, newBaseKustomization(app, resources))
 	if err != nil {
 		return manifests, err
 	}
@@ -138,17 +262,34 @@ func (r *Renderer) RenderManifests(app *models.Application) (map[string]string,
 
 // RenderDeploySpec renders and argocd deployment spec
 func (r *Renderer) RenderDeploySpec(app *models.Application) (string, error) {
-	templateFile, err := templateFile(r.templateDir, "argocd-application.yaml")
+	cluster, err := r.clusters.Lookup(app.Metadata.Labels.Region, app.Metadata.Labels.Env)
 	if err != nil {
-		return "", errors.Wrapf(err, errTemplateUnreadableFormat)
+		return "", err
 	}
 
-	log.Infof("rendering %q", templateFile)
-	return renderTemplate(templateFile, app)
+	data := struct {
+		App        *models.Application
+		Cluster    *Cluster
+		Project    string
+		Path       string
+		ValueFiles []string
+	}{App: app, Cluster: cluster, Project: applicationProject(app, cluster), Path: SourcePath(app), ValueFiles: ValueFiles(app)}
+
+	t, err := r.lookupTemplate(app, applicationTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	log.Infof("rendering %q", t.Name())
+	return renderTemplate(t, data)
 }
 
 // RenderApplication renders an application to Kubernetes manifests
 func (r *Renderer) RenderApplication(app *models.Application) (string, error) {
+	if Format(app) == models.DestinationFormatHelm {
+		return r.renderChartPreview(app)
+	}
+
 	var results []string
 	manifests, err := r.RenderManifests(app)
 	if err != nil {
@@ -156,19 +297,33 @@ func (r *Renderer) RenderApplication(app *models.Application) (string, error) {
 	}
 
 	// render in a specific order
-	results = append(results, manifests["service-account.yaml"])
+	base := func(name string) string { return manifests[path.Join(baseDir, name)] }
+	results = append(results, base("service-account.yaml"))
 	for _, component := range app.Spec.Components {
-		results = append(results, manifests[serviceName(component.Service)])
-		results = append(results, manifests[deploymentName(component.Service)])
+		results = append(results, base(serviceName(component.Service)))
+		results = append(results, base(deploymentName(component.Service)))
 		for _, ingress := range component.Ingresses {
-			results = append(results, manifests[ingressName(ingress)])
+			results = append(results, base(ingressName(ingress)))
 		}
 	}
 	for _, configMap := range app.Spec.ConfigMaps {
-		results = append(results, manifests[configMapName(configMap)])
+		results = append(results, base(configMapName(configMap)))
 	}
 	for _, persistentVolume := range app.Spec.PersistentVolumes {
-		results = append(results, manifests[persistentVolumeName(persistentVolume)])
+		results = append(results, base(persistentVolumeName(persistentVolume)))
+	}
+
+	// followed by the patches of the overlay the application is deployed with
+	env := app.Metadata.Labels.Env
+	for _, component := range app.Spec.Components {
+		if patch, ok := manifests[path.Join(overlayDir(env), deploymentName(component.Service))]; ok {
+			results = append(results, patch)
+		}
+		for _, ingress := range component.Ingresses {
+			if patch, ok := manifests[path.Join(overlayDir(env), ingressName(ingress))]; ok {
+				results = append(results, patch)
+			}
+		}
 	}
 
 	return strings.Join(results, "\n\n---\n"), nil
@@ -183,16 +338,12 @@ func (r *Renderer) renderServices(app *models.Application) (map[string]string, e
 	}{App: app}
 
 	for _, tmpl := range templates["services"] {
-		templateFile, err := templateFile(r.templateDir, tmpl)
-		if err != nil {
-			return manifests, errors.Wrapf(err, errTemplateUnreadableFormat)
-		}
-
 		for _, component := range app.Spec.Components {
 			service := component.Service
-			log.Infof("rendering %q", templateFile)
 			data.Service = service
-			result, err := renderTemplate(templateFile, data)
+			result, err := r.renderResource(app, tmpl, data, func() interface{} {
+				return newTypedService(app, service)
+			})
 			if err != nil {
 				return manifests, err
 			}
@@ -206,31 +357,16 @@ func (r *Renderer) renderServices(app *models.Application) (map[string]string, e
 func (r *Renderer) renderIngresses(app *models.Application) (map[string]string, error) {
 	manifests := map[string]string{}
 
-	data := struct {
-		App         *models.Application
-		Ingress     *models.Ingress
-		IngressPath *models.IngressPath
-		Service     *models.Service
-	}{App: app}
-
 	log.Infof("rendering ingresses")
 
-	for _, tmpl := range templates["ingresses"] {
-		templateFile, err := templateFile(r.templateDir, tmpl)
-		if err != nil {
-			return manifests, errors.Wrapf(err, errTemplateUnreadableFormat)
-		}
+	apiVersions := r.APIVersions(app)
 
+	for _, tmpl := range templates["ingresses"] {
 		for _, component := range app.Spec.Components {
 			service := component.Service
 			log.Infof("renderIngresses: service: %s", service.Name)
 			for _, ingress := range component.Ingresses {
-				log.Infof("rendering %q", templateFile)
-				data.Ingress = ingress
-				data.IngressPath = ingress.Paths[0]
-				data.Service = service
-				log.Infof("renderIngresses: data: %+v", data)
-				result, err := renderTemplate(templateFile, data)
+				result, err := r.renderIngressResource(app, tmpl, apiVersions, service, ingress)
 				if err != nil {
 					return manifests, err
 				}
@@ -245,6 +381,41 @@ func (r *Renderer) renderIngresses(app *models.Application) (map[string]string,
 	return manifests, nil
 }
 
+// renderIngress renders a single ingress of a service
+func (r *Renderer) renderIngress(app *models.Application, service *models.Service, ingress *models.Ingress) (string, error) {
+	var results []string
+	for _, tmpl := range templates["ingresses"] {
+		result, err := r.renderIngressResource(app, tmpl, r.APIVersions(app), service, ingress)
+		if err != nil {
+			return "", err
+		}
+		results = append(results, result)
+	}
+	return strings.Join(results, "---\n"), nil
+}
+
+// renderIngressResource renders an ingress. APIVersions holds the API
+// version of each kind of kindAPIVersions the target Kubernetes version
+// serves, so templates can follow API deprecations.
+func (r *Renderer) renderIngressResource(app *models.Application, tmpl string, apiVersions map[string]string, service *models.Service, ingress *models.Ingress) (string, error) {
+	data := struct {
+		App         *models.Application
+		APIVersions map[string]string
+		Ingress     *models.Ingress
+		IngressPath *models.IngressPath
+		Service     *models.Service
+	}{
+		App:         app,
+		APIVersions: apiVersions,
+		Ingress:     ingress,
+		IngressPath: ingress.Paths[0],
+		Service:     service,
+	}
+	return r.renderResource(app, tmpl, data, func() interface{} {
+		return newTypedIngress(app, apiVersions["Ingress"], service, ingress)
+	})
+}
+
 func (r *Renderer) renderConfigMaps(app *models.Application) (map[string]string, error) {
 	manifests := map[string]string{}
 
@@ -254,15 +425,11 @@ func (r *Renderer) renderConfigMaps(app *models.Application) (map[string]string,
 	}{App: app}
 
 	for _, tmpl := range templates["configmaps"] {
-		templateFile, err := templateFile(r.templateDir, tmpl)
-		if err != nil {
-			return manifests, errors.Wrapf(err, errTemplateUnreadableFormat)
-		}
-
 		for _, configMap := range app.Spec.ConfigMaps {
-			log.Infof("rendering %q", templateFile)
 			data.ConfigMap = configMap
-			result, err := renderTemplate(templateFile, data)
+			result, err := r.renderResource(app, tmpl, data, func() interface{} {
+				return newTypedConfigMap(app, configMap)
+			})
 			if err != nil {
 				return manifests, err
 			}
@@ -282,15 +449,11 @@ func (r *Renderer) renderPersistentVolumes(app *models.Application) (map[string]
 	}{App: app}
 
 	for _, tmpl := range templates["persistentvolumes"] {
-		templateFile, err := templateFile(r.templateDir, tmpl)
-		if err != nil {
-			return manifests, errors.Wrapf(err, errTemplateUnreadableFormat)
-		}
-
 		for _, persistentVolume := range app.Spec.PersistentVolumes {
-			log.Infof("rendering %q", templateFile)
 			data.PersistentVolume = persistentVolume
-			result, err := renderTemplate(templateFile, data)
+			result, err := r.renderResource(app, tmpl, data, func() interface{} {
+				return newTypedPersistentVolumeClaim(app, persistentVolume)
+			})
 			if err != nil {
 				return manifests, err
 			}
@@ -307,6 +470,7 @@ func (r *Renderer) renderDeployments(app *models.Application) (map[string]string
 	data := struct {
 		App                   *models.Application
 		Service               *models.Service
+		Replicas              int64
 		ConfigMapNames        []string
 		PersistentVolumeNames []string
 		Containers            []*models.Container
@@ -336,16 +500,13 @@ func (r *Renderer) renderDeployments(app *models.Application) (map[string]string
 	data.PersistentVolumeNames = mapKeys(pvs)
 
 	for _, tmpl := range templates["deployment"] {
-		templateFile, err := templateFile(r.templateDir, tmpl)
-		if err != nil {
-			return manifests, errors.Wrapf(err, errTemplateUnreadableFormat)
-		}
-
 		for _, component := range app.Spec.Components {
-			log.Infof("rendering %q", templateFile)
 			data.Service = component.Service
+			data.Replicas = component.Replicas
 			data.Containers = component.Containers
-			result, err := renderTemplate(templateFile, data)
+			result, err := r.renderResource(app, tmpl, data, func() interface{} {
+				return newTypedDeployment(app, component, data.ConfigMapNames, data.PersistentVolumeNames)
+			})
 			if err != nil {
 				return manifests, err
 			}
@@ -356,37 +517,17 @@ func (r *Renderer) renderDeployments(app *models.Application) (map[string]string
 	return manifests, nil
 }
 
-// RenderTemplate renders the specified template with the Application model
-func renderTemplate(name string, obj interface{}) (string, error) {
-	data, err := ioutil.ReadFile(name)
-	if err != nil {
-		return "", errors.Wrapf(err, "failed to read template %q", name)
-	}
-
-	t, err := template.New("resources").Parse(string(data))
-	if err != nil {
-		return "", errors.Wrapf(err, "failed to parse template %q", name)
-	}
-
+// renderTemplate executes a parsed template with the specified data
+func renderTemplate(t *template.Template, obj interface{}) (string, error) {
 	var rendered bytes.Buffer
-	err = t.Execute(&rendered, obj)
+	err := t.Execute(&rendered, obj)
 	if err != nil {
-		return "", errors.Wrapf(err, "failed to execute template %q", name)
+		return "", errors.Wrapf(err, "failed to execute template %q", t.Name())
 	}
 
 	return rendered.String(), nil
 }
 
-func templateFile(templateDir, name string) (string, error) {
-	filename := path.Join(templateDir, name)
-
-	_, err := os.Stat(filename)
-	if err != nil {
-		return "", err
-	}
-	return filename, nil
-}
-
 func serviceName(s *models.Service) string {
 	return fmt.Sprintf("service-%s.yaml", s.Name)
 }
@@ -407,12 +548,13 @@ func persistentVolumeName(pv *models.PersistentVolume) string {
 	return fmt.Sprintf("persistent-volume-%s.yaml", pv.Name)
 }
 
+// mapKeys returns the keys of a set in sorted order, so that what is rendered
+// from them does not change between renders
 func mapKeys(m map[string]struct{}) []string {
-	keys := make([]string, len(m))
-	i := 0
+	keys := make([]string, 0, len(m))
 	for k := range m {
-		keys[i] = k
-		i++
+		keys = append(keys, k)
 	}
+	sort.Strings(keys)
 	return keys
 }
@@ -149,7 +149,7 @@ spec:
 			app: app1
 			component: app1
 	strategy:
-		type: RollingUpdate
+		type: Recreate
 	template:
 		metadata:
 			labels:
@@ -180,9 +180,6 @@ spec:
 				image: nginx:alpine
 				imagePullPolicy: IfNotPresent
 				volumeMounts:
-				- mountPath: /etc/ssl/certs
-					name: ca-bundles
-					readOnly: true
 				- mountPath: /config
 					name: config
 					readOnly: true
@@ -198,6 +195,31 @@ spec:
 					protocol: TCP
 
 
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+	annotations:
+		kubernetes.io/ingress.class: "nginx"
+	labels:
+		component: app1
+		app: app1
+		release: v1
+	name: app1
+spec:
+	rules:
+	- host: app1.mc.int
+		http:
+			paths:
+			- backend:
+					service:
+						name: app1
+						port:
+							name: http
+				path: /
+				pathType: ImplementationSpecific
+
+
 ---
 apiVersion: v1
 kind: ConfigMap
@@ -244,20 +266,20 @@ func TestRenderManifests(t *testing.T) {
 		t.Error(err)
 	}
 
-	if _, ok := results["service-account.yaml"]; !ok {
-		t.Error("service-account.yaml not found")
+	if _, ok := results["base/service-account.yaml"]; !ok {
+		t.Error("base/service-account.yaml not found")
 		t.Log(results)
 		t.FailNow()
 	}
 
-	if _, ok := results["service-app1.yaml"]; !ok {
-		t.Error("service-app1.yaml not found")
+	if _, ok := results["base/service-app1.yaml"]; !ok {
+		t.Error("base/service-app1.yaml not found")
 		t.Log(results)
 		t.FailNow()
 	}
 
-	if _, ok := results["deployment-app1.yaml"]; !ok {
-		t.Error("deployment-app1.yaml not found")
+	if _, ok := results["base/deployment-app1.yaml"]; !ok {
+		t.Error("base/deployment-app1.yaml not found")
 		t.Log(results)
 		t.FailNow()
 	}
@@ -282,3 +304,29 @@ func TestRenderApplication(t *testing.T) {
 		t.Errorf("Result not as expected:\n%v", diff.LineDiff(result, expected))
 	}
 }
+
+func TestRenderManifestsMultilineConfigMap(t *testing.T) {
+	spec := *validApplication.Spec
+	spec.ConfigMaps = []*models.ConfigMap{
+		{
+			Name: "config",
+			Data: "debug: true\nlevel: info",
+		},
+	}
+	app := application.ApplyDefaults(&models.Application{Metadata: validApplication.Metadata, Spec: &spec})
+
+	renderer, err := application.NewRenderer("../../_templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := renderer.RenderManifests(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "  data: |\n    debug: true\n    level: info\n"
+	if result := results["base/configmap-config.yaml"]; !strings.Contains(result, expected) {
+		t.Errorf("expected the data indented as a block, got:\n%s", result)
+	}
+}
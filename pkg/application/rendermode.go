@@ -0,0 +1,199 @@
+package application
+
+import (
+	"path"
+	"strings"
+	"text/template"
+
+	"deploy-wizard/gen/models"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// RenderMode selects how the renderer produces the manifests of the core
+// kinds: Deployments, Services, Ingresses, ConfigMaps and
+// PersistentVolumeClaims
+type RenderMode string
+
+const (
+	// RenderModeTemplate executes the template of each kind
+	RenderModeTemplate RenderMode = "template"
+	// RenderModeTyped builds each resource as a typed object and marshals
+	// it, merging the patch template of its kind into it if there is one
+	RenderModeTyped RenderMode = "typed"
+)
+
+// RenderModes are the supported render modes
+var RenderModes = []RenderMode{RenderModeTemplate, RenderModeTyped}
+
+// patchTemplateDir is the directory of the optional templates of the typed
+// render mode. A template in it is named after the template of its kind,
+// e.g. patches/deployment.yaml.
+const patchTemplateDir = "patches"
+
+func patchTemplatePath(name string) string {
+	return path.Join(patchTemplateDir, name)
+}
+
+func isPatchTemplate(name string) bool {
+	return strings.HasPrefix(name, patchTemplateDir+"/")
+}
+
+func (m RenderMode) valid() bool {
+	for _, mode := range RenderModes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// renderResource renders a resource of a core kind in the render mode of the
+// renderer: by executing the template name with data, or by marshaling the
+// object built by build with its patch template merged into it
+func (r *Renderer) renderResource(app *models.Application, name string, data interface{}, build func() interface{}) (string, error) {
+	if r.renderMode != RenderModeTyped {
+		t, err := r.lookupTemplate(app, name)
+		if err != nil {
+			return "", err
+		}
+		log.Infof("rendering %q", t.Name())
+		return renderTemplate(t, data)
+	}
+
+	log.Infof("building %q", name)
+	out, err := yaml.Marshal(build())
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to marshal the %q resource", name)
+	}
+
+	t, ok := r.templateSet(app).parsed[patchTemplatePath(name)]
+	if !ok {
+		return string(out), nil
+	}
+
+	log.Infof("rendering %q", t.Name())
+	patch, err := renderTemplate(t, data)
+	if err != nil {
+		return "", err
+	}
+	merged, err := mergePatch(out, []byte(patch))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to apply the patch template %q", t.Name())
+	}
+	return string(merged), nil
+}
+
+// mergePatch merges a YAML patch into a YAML document. Mappings are merged
+// key by key and sequences of mappings item by item when every item has a
+// name, like strategic merge patches merge containers, ports and volumes.
+// Anything else in the patch replaces what the document holds, and null
+// removes it.
+func mergePatch(doc, patch []byte) ([]byte, error) {
+	var base, overlay yaml.MapSlice
+	if err := yaml.Unmarshal(doc, &base); err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(patch, &overlay); err != nil {
+		return nil, errors.Wrap(err, "the patch is not a valid YAML mapping")
+	}
+	return yaml.Marshal(mergeMapSlice(base, overlay))
+}
+
+func mergeMapSlice(base, patch yaml.MapSlice) yaml.MapSlice {
+	merged := append(yaml.MapSlice{}, base...)
+	for _, item := range patch {
+		i := indexOfKey(merged, item.Key)
+		switch {
+		case item.Value == nil && i >= 0:
+			merged = append(merged[:i], merged[i+1:]...)
+		case item.Value == nil:
+		case i < 0:
+			merged = append(merged, item)
+		default:
+			merged[i].Value = mergeValue(merged[i].Value, item.Value)
+		}
+	}
+	return merged
+}
+
+func mergeValue(base, patch interface{}) interface{} {
+	switch patch := patch.(type) {
+	case yaml.MapSlice:
+		if base, ok := base.(yaml.MapSlice); ok {
+			return mergeMapSlice(base, patch)
+		}
+	case []interface{}:
+		if base, ok := base.([]interface{}); ok && namedItems(base) && namedItems(patch) {
+			return mergeNamedItems(base, patch)
+		}
+	}
+	return patch
+}
+
+// mergeNamedItems merges the items of a patch sequence into the base items of
+// the same name and appends the others
+func mergeNamedItems(base, patch []interface{}) []interface{} {
+	merged := append([]interface{}{}, base...)
+	for _, item := range patch {
+		name := itemName(item)
+		found := false
+		for i := range merged {
+			if itemName(merged[i]) == name {
+				merged[i] = mergeMapSlice(merged[i].(yaml.MapSlice), item.(yaml.MapSlice))
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, item)
+		}
+	}
+	return merged
+}
+
+func namedItems(items []interface{}) bool {
+	for _, item := range items {
+		if itemName(item) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func itemName(item interface{}) interface{} {
+	m, ok := item.(yaml.MapSlice)
+	if !ok {
+		return nil
+	}
+	if i := indexOfKey(m, "name"); i >= 0 {
+		return m[i].Value
+	}
+	return nil
+}
+
+func indexOfKey(m yaml.MapSlice, key interface{}) int {
+	for i, item := range m {
+		if item.Key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// parsePatchTemplates parses the optional patch templates of a template set
+func parsePatchTemplates(set *templateSet) error {
+	for name, file := range set.files {
+		if !isPatchTemplate(name) {
+			continue
+		}
+		t, err := template.New(name).Funcs(templateFuncs).Parse(file.content)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse the %s template %q", file.layer, name)
+		}
+		set.parsed[name] = t
+	}
+	return nil
+}
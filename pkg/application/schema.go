@@ -0,0 +1,64 @@
+package application
+
+import (
+	"context"
+	"path"
+
+	"deploy-wizard/gen/models"
+)
+
+const errMsgSchema = "the manifest does not match the Kubernetes schemas: %s"
+
+// ValidateManifests checks rendered manifests against the schemas of the
+// Kubernetes version the application is deployed to and returns the problems
+// found by file, including resources of kinds that version does not serve.
+// Overlay patches only hold the fields they change and kustomizations are not
+// Kubernetes resources, so only the base resources of a Kustomize base are
+// checked. The templates of a Helm chart are only valid YAML once rendered, so
+// the resources the chart renders with the values of each env are checked
+// instead, keyed like those of RenderResources.
+func (r *Renderer) ValidateManifests(app *models.Application, manifests map[string]string) (map[string][]string, error) {
+	problems := map[string][]string{}
+
+	resources := map[string]string{}
+	if Format(app) == models.DestinationFormatHelm {
+		var err error
+		if resources, err = r.RenderResources(app); err != nil {
+			return nil, err
+		}
+	} else {
+		for filename, content := range manifests {
+			if path.Dir(filename) == baseDir && path.Base(filename) != templates["kustomization"][0] {
+				resources[filename] = content
+			}
+		}
+	}
+
+	validator := r.validatorFor(app)
+	for filename, content := range resources {
+		if p := validator.Validate(content); len(p) > 0 {
+			problems[filename] = p
+		}
+	}
+	return problems, nil
+}
+
+// CheckManifests checks rendered manifests the way a release does: against the
+// schemas of the Kubernetes version the application is deployed to and
+// against the Rego policies of the renderer. The problems are added to the
+// errors of the findings by file under manifests, resources of kinds that
+// version does not serve under the application.
+func (r *Renderer) CheckManifests(ctx context.Context, app *models.Application, manifests map[string]string, findings *Findings) error {
+	mergeValidationErrors(findings.Errors, r.ValidateAPIVersions(app))
+
+	problems, err := r.ValidateManifests(app, manifests)
+	if err != nil {
+		return err
+	}
+	for filename, fileProblems := range problems {
+		for _, problem := range fileProblems {
+			addFileMessage(findings.Errors, "manifests", filename, newValidationError(errMsgSchema, problem))
+		}
+	}
+	return r.EvaluatePolicies(ctx, app, findings)
+}
@@ -0,0 +1,178 @@
+package application_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"deploy-wizard/gen/models"
+	"deploy-wizard/pkg/application"
+	"deploy-wizard/pkg/schema"
+)
+
+func TestValidateManifests(t *testing.T) {
+	app := application.ApplyDefaults(validApplication)
+
+	for _, version := range schema.Versions() {
+		renderer, err := application.NewRenderer("", application.WithKubernetesVersion(version))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		manifests, err := renderer.RenderManifests(app)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		problems, err := renderer.ValidateManifests(app, manifests)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for filename, fileProblems := range problems {
+			t.Errorf("%s on Kubernetes %s: %v", filename, version, fileProblems)
+		}
+	}
+}
+
+// writeInvalidServiceTemplate writes a service template with a port that is
+// not a number to a temporary template directory
+func writeInvalidServiceTemplate(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := "apiVersion: v1\nkind: Service\nmetadata:\n  name: {{.Service.Name}}\nspec:\n  ports:\n  - port: {{.Service.Name}}\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "service.yaml"), []byte(service), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestValidateManifestsInvalidTemplate(t *testing.T) {
+	dir := writeInvalidServiceTemplate(t)
+	defer os.RemoveAll(dir)
+
+	renderer, err := application.NewRenderer(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	preview, err := renderer.RenderPreview(application.ApplyDefaults(validApplication))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, file := range preview.Files {
+		switch file.Name {
+		case "base/service-app1.yaml":
+			if len(file.Errors) != 1 || !strings.Contains(file.Errors[0], "spec.ports.port in body must be of type integer") {
+				t.Errorf("expected a schema error, got %v", file.Errors)
+			}
+		default:
+			if len(file.Errors) > 0 {
+				t.Errorf("%s: expected no schema errors, got %v", file.Name, file.Errors)
+			}
+		}
+	}
+}
+
+func TestCheckManifests(t *testing.T) {
+	dir := writeInvalidServiceTemplate(t)
+	defer os.RemoveAll(dir)
+
+	renderer, err := application.NewRenderer(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	app := application.ApplyDefaults(validApplication)
+	manifests, err := renderer.RenderManifests(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// release and bundle report schema problems like the other findings
+	findings := application.CheckApplication(app)
+	if err := renderer.CheckManifests(context.Background(), app, manifests, findings); err != nil {
+		t.Fatal(err)
+	}
+	issues := application.Issues(findings.Errors, models.ValidationIssueSeverityError)
+	if len(issues) != 1 || issues[0].Code != "schema" || issues[0].Path != "/manifests/base~1service-app1.yaml/0" {
+		t.Errorf("expected a schema issue for the service, got %s", toJSON(t, issues))
+	}
+}
+
+func TestValidateManifestsHelm(t *testing.T) {
+	renderer, err := application.NewRenderer("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	app := newDeterminismApplication(models.DestinationFormatHelm)
+	manifests, err := renderer.RenderManifests(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	problems, err := renderer.ValidateManifests(app, manifests)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(problems) > 0 {
+		t.Errorf("expected no problems, got %v", problems)
+	}
+}
+
+func TestValidateManifestsHelmInvalidTemplate(t *testing.T) {
+	dir := writeInvalidServiceTemplate(t)
+	defer os.RemoveAll(dir)
+
+	renderer, err := application.NewRenderer(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the resources the chart renders with the values of each env are checked
+	app := newDeterminismApplication(models.DestinationFormatHelm)
+	manifests, err := renderer.RenderManifests(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	problems, err := renderer.ValidateManifests(app, manifests)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, filename := range []string{"values.yaml/service-web.yaml", "values-prod.yaml/service-web.yaml"} {
+		if len(problems[filename]) != 1 {
+			t.Errorf("expected a schema error for %s, got %v", filename, problems)
+		}
+	}
+
+	// and reported on the values file by the preview
+	preview, err := renderer.RenderPreview(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range preview.Files {
+		switch file.Name {
+		case "values.yaml", "values-prod.yaml":
+			if len(file.Errors) != 3 || !strings.HasPrefix(file.Errors[0], "service-api.yaml: ") {
+				t.Errorf("%s: expected a schema error for each service, got %v", file.Name, file.Errors)
+			}
+		default:
+			if len(file.Errors) > 0 {
+				t.Errorf("%s: expected no schema errors, got %v", file.Name, file.Errors)
+			}
+		}
+	}
+}
+
+func TestNewRendererUnknownKubernetesVersion(t *testing.T) {
+	if _, err := application.NewRenderer("", application.WithKubernetesVersion("1.0")); err == nil {
+		t.Error("expected an error for a Kubernetes version without schemas")
+	}
+}
@@ -0,0 +1,111 @@
+package application
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+
+	"deploy-wizard/gen/models"
+)
+
+// SpecFile is the file a release commits the application to, under the
+// destination path next to its manifests
+const SpecFile = "application.json"
+
+// FilesFile is the file a release records the files it commits under the
+// destination path to, relative to that path. Only the recorded files are
+// ever pruned.
+const FilesFile = "files.json"
+
+// specPath returns the path of the spec file under a destination path,
+// relative to the repository root
+func specPath(destinationPath string) string {
+	return path.Join(strings.TrimPrefix(destinationPath, "/"), SpecFile)
+}
+
+// RenderSpec renders the application as committed to its spec file
+func RenderSpec(app *models.Application) (string, error) {
+	spec, err := json.MarshalIndent(app, "", "  ")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal the application")
+	}
+	return string(spec) + "\n", nil
+}
+
+// LoadSpec reads the application committed to the spec file under a
+// destination path. The error of read is returned as is when the file does
+// not exist.
+func LoadSpec(destinationPath string, read FileReader) (*models.Application, error) {
+	filename := specPath(destinationPath)
+	spec, err := read(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var app models.Application
+	if err := json.Unmarshal([]byte(spec), &app); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %s", filename)
+	}
+	if err := app.Validate(strfmt.Default); err != nil {
+		return nil, errors.Wrapf(err, "invalid application in %s", filename)
+	}
+	return &app, nil
+}
+
+// RenderFiles renders the record of the files a release commits under its
+// destination path, relative to that path
+func RenderFiles(filenames []string) (string, error) {
+	sorted := append([]string{}, filenames...)
+	sort.Strings(sorted)
+	files, err := json.MarshalIndent(sorted, "", "  ")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal the released files")
+	}
+	return string(files) + "\n", nil
+}
+
+// AddReleaseRecord adds the spec file of the application and the record of
+// the files a release commits under its destination path to the rendered
+// manifests
+func AddReleaseRecord(app *models.Application, manifests map[string]string) error {
+	spec, err := RenderSpec(app)
+	if err != nil {
+		return err
+	}
+	manifests[SpecFile] = spec
+
+	var released []string
+	for filename := range manifests {
+		released = append(released, filename)
+	}
+	record, err := RenderFiles(released)
+	if err != nil {
+		return err
+	}
+	manifests[FilesFile] = record
+	return nil
+}
+
+// LoadFiles reads the files the previous release recorded under a destination
+// path, relative to that path. A destination without a record has none.
+func LoadFiles(destinationPath string, read FileReader) ([]string, error) {
+	filename := path.Join(strings.TrimPrefix(destinationPath, "/"), FilesFile)
+	content, err := read(filename)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	if err := json.Unmarshal([]byte(content), &files); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %s", filename)
+	}
+	return files, nil
+}
@@ -0,0 +1,57 @@
+package application_test
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"deploy-wizard/gen/models"
+	"deploy-wizard/pkg/application"
+)
+
+func TestLoadSpec(t *testing.T) {
+	app := application.ApplyDefaults(newDeterminismApplication(models.DestinationFormatKustomize))
+	app.Spec.Destination.Path = "/deploy"
+	for _, component := range app.Spec.Components {
+		for _, container := range component.Containers {
+			// the API requires the pull policy of a released application
+			container.ImagePullPolicy = models.ContainerImagePullPolicyIfNotPresent
+		}
+	}
+
+	renderer, err := application.NewRenderer("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := renderer.RenderBundle(app, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := files["deploy/application.json"]; !ok {
+		t.Fatalf("expected the spec file next to the manifests, got %v", files)
+	}
+
+	loaded, err := application.LoadSpec(app.Spec.Destination.Path, readManifests(files))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(loaded, app) {
+		t.Errorf("expected the released application %s, got %s", toJSON(t, app), toJSON(t, loaded))
+	}
+}
+
+func TestLoadSpecErrors(t *testing.T) {
+	if _, err := application.LoadSpec("/deploy", readManifests(map[string]string{})); !os.IsNotExist(err) {
+		t.Errorf("expected a not exist error for a path without a spec file, got %v", err)
+	}
+
+	for name, spec := range map[string]string{
+		"invalid JSON":        "{",
+		"invalid application": `{"metadata": {"name": "app1"}}`,
+	} {
+		files := map[string]string{"deploy/application.json": spec}
+		if _, err := application.LoadSpec("deploy", readManifests(files)); err == nil || os.IsNotExist(err) {
+			t.Errorf("%s: expected an error, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,363 @@
+package application
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"deploy-wizard/gen/models"
+	defaulttemplates "deploy-wizard/pkg/templates"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// templateReloadDelay is how long the watcher waits for further changes
+// before it reloads, so that an editor saving several files or a
+// replaced ConfigMap volume reloads once
+const templateReloadDelay = 200 * time.Millisecond
+
+// templateFile is a template and the layer it comes from
+type templateFile struct {
+	content string
+	layer   string
+}
+
+// templateSet holds the effective templates of the renderer or of a team,
+// keyed by their slash separated path relative to the template directory
+type templateSet struct {
+	files  map[string]templateFile
+	parsed map[string]*template.Template
+}
+
+// templateCache holds the template set of the renderer and the ones of the
+// teams that override templates, keyed by the lowercased team like
+// ProjectName
+type templateCache struct {
+	base  *templateSet
+	teams map[string]*templateSet
+}
+
+// requiredTemplates returns the templates the renderer needs to render every
+// format and deploy target
+func requiredTemplates() []string {
+	var required []string
+	add := func(name string) {
+		if !containsString(required, name) {
+			required = append(required, name)
+		}
+	}
+
+	for _, names := range templates {
+		for _, name := range names {
+			add(name)
+		}
+	}
+	add(applicationTemplate)
+	add(projectTemplate)
+	for _, names := range fluxTemplates {
+		for _, name := range names {
+			add(name)
+		}
+	}
+	add(path.Join(chartTemplateDir, chartFile))
+	for _, name := range chartTemplates {
+		add(chartTemplatePath(name))
+	}
+
+	sort.Strings(required)
+	return required
+}
+
+// chartTemplatePath returns the path of a chart template in the template
+// directory
+func chartTemplatePath(name string) string {
+	return path.Join(chartTemplateDir, "templates", name)
+}
+
+// loadTemplates layers the templates of the override directory over the
+// embedded ones, and the templates of each team directory over both. Empty
+// directories are left out.
+func loadTemplates(overrideDir, teamDir string) (*templateCache, error) {
+	files := map[string]templateFile{}
+	for name, content := range defaulttemplates.Files() {
+		files[name] = templateFile{content: content, layer: models.TemplateFileLayerEmbedded}
+	}
+
+	if overrideDir != "" {
+		if err := readTemplateDir(overrideDir, models.TemplateFileLayerOverride, files); err != nil {
+			return nil, err
+		}
+	}
+
+	base, err := newTemplateSet(files)
+	if err != nil {
+		return nil, err
+	}
+	cache := &templateCache{base: base, teams: map[string]*templateSet{}}
+
+	if teamDir == "" {
+		return cache, nil
+	}
+
+	teamDirs, err := ioutil.ReadDir(teamDir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read team template directory %q", teamDir)
+	}
+	for _, dir := range teamDirs {
+		if !dir.IsDir() || strings.HasPrefix(dir.Name(), ".") {
+			continue
+		}
+
+		team := strings.ToLower(dir.Name())
+		if _, ok := cache.teams[team]; ok {
+			return nil, errors.Errorf("team template directories of %q differ only in case", team)
+		}
+
+		teamFiles := map[string]templateFile{}
+		for name, file := range files {
+			teamFiles[name] = file
+		}
+		if err := readTemplateDir(filepath.Join(teamDir, dir.Name()), models.TemplateFileLayerTeam, teamFiles); err != nil {
+			return nil, err
+		}
+
+		set, err := newTemplateSet(teamFiles)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid templates of team %q", dir.Name())
+		}
+		cache.teams[team] = set
+	}
+
+	return cache, nil
+}
+
+// readTemplateDir adds the templates of a directory to files, replacing the
+// ones of lower layers
+func readTemplateDir(dir, layer string, files map[string]templateFile) error {
+	err := filepath.Walk(dir, func(filename string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if filename != dir && strings.HasPrefix(info.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !containsString(defaulttemplates.Extensions, filepath.Ext(filename)) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, filename)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read template %q", name)
+		}
+		files[name] = templateFile{content: string(data), layer: layer}
+		return nil
+	})
+	return errors.Wrapf(err, "failed to load templates from %q", dir)
+}
+
+// newTemplateSet parses the templates the renderer executes and the patch
+// templates of the typed render mode. Chart templates are kept as they are
+// since Helm renders them.
+func newTemplateSet(files map[string]templateFile) (*templateSet, error) {
+	set := &templateSet{files: files, parsed: map[string]*template.Template{}}
+
+	var missing []string
+	for _, name := range requiredTemplates() {
+		file, ok := files[name]
+		if !ok {
+			missing = append(missing, name)
+			continue
+		}
+		if strings.HasPrefix(name, chartTemplatePath("")+"/") {
+			continue
+		}
+
+		t, err := template.New(name).Funcs(templateFuncs).Parse(file.content)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse the %s template %q", file.layer, name)
+		}
+		set.parsed[name] = t
+	}
+	if len(missing) > 0 {
+		return nil, errors.Errorf("missing the templates %s", strings.Join(missing, ", "))
+	}
+
+	if err := parsePatchTemplates(set); err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
+
+func (r *Renderer) templateCache() *templateCache {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cache
+}
+
+// templateSet returns the templates an application is rendered with: the
+// ones of its team if the team overrides templates
+func (r *Renderer) templateSet(app *models.Application) *templateSet {
+	cache := r.templateCache()
+	if app != nil && app.Metadata != nil && app.Metadata.Labels != nil {
+		if set, ok := cache.teams[strings.ToLower(app.Metadata.Labels.Team)]; ok {
+			return set
+		}
+	}
+	return cache.base
+}
+
+// lookupTemplate returns a parsed template an application is rendered with
+// by its path in the template directory
+func (r *Renderer) lookupTemplate(app *models.Application, name string) (*template.Template, error) {
+	t, ok := r.templateSet(app).parsed[name]
+	if !ok {
+		return nil, errors.Errorf(errTemplateUnreadableFormat, name)
+	}
+	return t, nil
+}
+
+// templateContent returns the unparsed content of a template an application
+// is rendered with by its path in the template directory
+func (r *Renderer) templateContent(app *models.Application, name string) (string, error) {
+	file, ok := r.templateSet(app).files[name]
+	if !ok {
+		return "", errors.Errorf(errTemplateUnreadableFormat, name)
+	}
+	return file.content, nil
+}
+
+// Templates returns the effective templates of a team, or of the renderer if
+// team is empty, with the layer each comes from
+func (r *Renderer) Templates(team string) []*models.TemplateFile {
+	cache := r.templateCache()
+	set, ok := cache.teams[strings.ToLower(team)]
+	if !ok {
+		set = cache.base
+	}
+
+	var names []string
+	for name := range set.files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	files := make([]*models.TemplateFile, 0, len(names))
+	for _, name := range names {
+		files = append(files, &models.TemplateFile{Name: name, Layer: set.files[name].layer})
+	}
+	return files
+}
+
+// ReloadTemplates reads the templates of the override and team directories
+// again and swaps them in at once. The templates in use are kept when a
+// template does not parse.
+func (r *Renderer) ReloadTemplates() error {
+	cache, err := loadTemplates(r.templateDir, r.teamTemplateDir)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.cache = cache
+	r.mu.Unlock()
+	return nil
+}
+
+// WatchTemplates reloads the templates whenever the override or team
+// directories change, until stop is closed. onReload, if set, is called with
+// the result of every reload.
+func (r *Renderer) WatchTemplates(stop <-chan struct{}, onReload func(error)) error {
+	var dirs []string
+	for _, dir := range []string{r.templateDir, r.teamTemplateDir} {
+		if dir != "" {
+			dirs = append(dirs, dir)
+		}
+	}
+	if len(dirs) == 0 {
+		return nil
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return errors.Wrap(err, "failed to create template watcher")
+	}
+
+	for _, dir := range dirs {
+		if err := watchDirs(watcher, dir); err != nil {
+			_ = watcher.Close()
+			return errors.Wrapf(err, "failed to watch template directory %q", dir)
+		}
+	}
+
+	go func() {
+		defer func() { _ = watcher.Close() }()
+
+		var reload <-chan time.Time
+		for {
+			select {
+			case <-stop:
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Op&fsnotify.Create != 0 {
+					if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+						if err := watchDirs(watcher, event.Name); err != nil {
+							log.Warnf("failed to watch template directory %q: %s", event.Name, err)
+						}
+					}
+				}
+				reload = time.After(templateReloadDelay)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Warnf("template watcher error: %s", err)
+			case <-reload:
+				reload = nil
+				err := r.ReloadTemplates()
+				if err != nil {
+					log.Errorf("failed to reload templates, keeping the templates in use: %s", err)
+				} else {
+					log.Infof("reloaded templates from %v", dirs)
+				}
+				if onReload != nil {
+					onReload(err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// watchDirs adds a directory and its subdirectories to a watcher
+func watchDirs(watcher *fsnotify.Watcher, dir string) error {
+	return filepath.Walk(dir, func(filename string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		return watcher.Add(filename)
+	})
+}
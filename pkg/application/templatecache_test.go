@@ -0,0 +1,245 @@
+package application_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"deploy-wizard/gen/models"
+	"deploy-wizard/pkg/application"
+)
+
+// copyTemplates copies the templates to a temporary directory the test can
+// change
+func copyTemplates(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = filepath.Walk("../../_templates", func(filename string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel("../../_templates", filename)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return os.MkdirAll(filepath.Join(dir, rel), 0755)
+		}
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(filepath.Join(dir, rel), data, 0644)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func appendTemplate(t *testing.T, dir, name, text string) {
+	filename := filepath.Join(dir, name)
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filename, append(data, text...), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func renderServiceAccount(t *testing.T, renderer *application.Renderer) string {
+	manifests, err := renderer.RenderManifests(application.ApplyDefaults(validApplication))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return manifests["base/service-account.yaml"]
+}
+
+func templateLayers(renderer *application.Renderer, team string) map[string]string {
+	layers := map[string]string{}
+	for _, file := range renderer.Templates(team) {
+		layers[file.Name] = file.Layer
+	}
+	return layers
+}
+
+func TestNewRendererEmbeddedTemplates(t *testing.T) {
+	renderer, err := application.NewRenderer("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result := renderServiceAccount(t, renderer); !strings.Contains(result, "kind: ServiceAccount") {
+		t.Errorf("expected the embedded template, got:\n%s", result)
+	}
+	if layer := templateLayers(renderer, "")["service-account.yaml"]; layer != models.TemplateFileLayerEmbedded {
+		t.Errorf("expected the embedded layer, got %q", layer)
+	}
+}
+
+func TestNewRendererOverrideTemplates(t *testing.T) {
+	dir, err := ioutil.TempDir("", "templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	err = ioutil.WriteFile(filepath.Join(dir, "service-account.yaml"), []byte("# override\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	renderer, err := application.NewRenderer(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result := renderServiceAccount(t, renderer); result != "# override\n" {
+		t.Errorf("expected the override template, got:\n%s", result)
+	}
+
+	layers := templateLayers(renderer, "")
+	if layer := layers["service-account.yaml"]; layer != models.TemplateFileLayerOverride {
+		t.Errorf("expected the override layer, got %q", layer)
+	}
+	if layer := layers["deployment.yaml"]; layer != models.TemplateFileLayerEmbedded {
+		t.Errorf("expected the embedded layer, got %q", layer)
+	}
+}
+
+func TestNewRendererTeamTemplates(t *testing.T) {
+	dir, err := ioutil.TempDir("", "teams")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	team := validApplication.Metadata.Labels.Team
+	if err := os.MkdirAll(filepath.Join(dir, team), 0755); err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, team, "service-account.yaml"), []byte("# {{ .Metadata.Labels.Team }}\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	renderer, err := application.NewRenderer("", application.WithTeamTemplates(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result := renderServiceAccount(t, renderer); result != "# "+team+"\n" {
+		t.Errorf("expected the team template, got:\n%s", result)
+	}
+	if layer := templateLayers(renderer, team)["service-account.yaml"]; layer != models.TemplateFileLayerTeam {
+		t.Errorf("expected the team layer, got %q", layer)
+	}
+	if layer := templateLayers(renderer, strings.ToUpper(team))["service-account.yaml"]; layer != models.TemplateFileLayerTeam {
+		t.Errorf("expected the team layer regardless of the case of the team, got %q", layer)
+	}
+	if layer := templateLayers(renderer, "other")["service-account.yaml"]; layer != models.TemplateFileLayerEmbedded {
+		t.Errorf("expected other teams to use the embedded layer, got %q", layer)
+	}
+}
+
+func TestNewRendererInvalidTeamTemplate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "teams")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := os.MkdirAll(filepath.Join(dir, "tenant2"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "tenant2", "deployment.yaml"), []byte("{{ .App "), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = application.NewRenderer("", application.WithTeamTemplates(dir))
+	if err == nil || !strings.Contains(err.Error(), "tenant2") {
+		t.Errorf("expected a parse error of the team template, got %v", err)
+	}
+}
+
+func TestNewRendererInvalidTemplate(t *testing.T) {
+	dir := copyTemplates(t)
+	defer os.RemoveAll(dir)
+
+	appendTemplate(t, dir, "deployment.yaml", "{{ .App.Metadata.Name ")
+
+	_, err := application.NewRenderer(dir)
+	if err == nil || !strings.Contains(err.Error(), "deployment.yaml") {
+		t.Errorf("expected a parse error, got %v", err)
+	}
+}
+
+func TestReloadTemplates(t *testing.T) {
+	dir := copyTemplates(t)
+	defer os.RemoveAll(dir)
+
+	renderer, err := application.NewRenderer(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appendTemplate(t, dir, "service-account.yaml", "# reloaded\n")
+	if result := renderServiceAccount(t, renderer); strings.Contains(result, "# reloaded") {
+		t.Error("expected the templates to be parsed once")
+	}
+
+	if err := renderer.ReloadTemplates(); err != nil {
+		t.Fatal(err)
+	}
+	if result := renderServiceAccount(t, renderer); !strings.Contains(result, "# reloaded") {
+		t.Errorf("expected the reloaded template, got:\n%s", result)
+	}
+
+	appendTemplate(t, dir, "service-account.yaml", "{{ .Metadata.Name ")
+	if err := renderer.ReloadTemplates(); err == nil {
+		t.Error("expected a parse error")
+	}
+	if result := renderServiceAccount(t, renderer); !strings.Contains(result, "# reloaded") {
+		t.Errorf("expected the templates in use to be kept, got:\n%s", result)
+	}
+}
+
+func TestWatchTemplates(t *testing.T) {
+	dir := copyTemplates(t)
+	defer os.RemoveAll(dir)
+
+	renderer, err := application.NewRenderer(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+	reloads := make(chan error, 10)
+	if err := renderer.WatchTemplates(stop, func(err error) { reloads <- err }); err != nil {
+		t.Fatal(err)
+	}
+
+	appendTemplate(t, dir, "service-account.yaml", "# watched\n")
+
+	select {
+	case err := <-reloads:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the templates to be reloaded")
+	}
+
+	if result := renderServiceAccount(t, renderer); !strings.Contains(result, "# watched") {
+		t.Errorf("expected the reloaded template, got:\n%s", result)
+	}
+}
@@ -0,0 +1,442 @@
+package application
+
+import (
+	"fmt"
+
+	"deploy-wizard/gen/models"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// The types below hold the fields of the core kinds the renderer builds in
+// the typed render mode. They mirror the Kubernetes API types, so that
+// marshaling them always produces valid YAML whatever the application holds.
+
+type objectMeta struct {
+	Name        string            `yaml:"name"`
+	Labels      map[string]string `yaml:"labels,omitempty"`
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
+
+type typedService struct {
+	APIVersion string      `yaml:"apiVersion"`
+	Kind       string      `yaml:"kind"`
+	Metadata   objectMeta  `yaml:"metadata"`
+	Spec       serviceSpec `yaml:"spec"`
+}
+
+type serviceSpec struct {
+	Ports    []servicePort     `yaml:"ports"`
+	Selector map[string]string `yaml:"selector"`
+	Type     string            `yaml:"type,omitempty"`
+}
+
+type servicePort struct {
+	Name       string `yaml:"name"`
+	Port       int64  `yaml:"port"`
+	Protocol   string `yaml:"protocol,omitempty"`
+	TargetPort int64  `yaml:"targetPort,omitempty"`
+}
+
+type typedIngress struct {
+	APIVersion string      `yaml:"apiVersion"`
+	Kind       string      `yaml:"kind"`
+	Metadata   objectMeta  `yaml:"metadata"`
+	Spec       ingressSpec `yaml:"spec"`
+}
+
+type ingressSpec struct {
+	Rules []ingressRule `yaml:"rules"`
+}
+
+type ingressRule struct {
+	Host string `yaml:"host"`
+	HTTP struct {
+		Paths []ingressPath `yaml:"paths"`
+	} `yaml:"http"`
+}
+
+type ingressPath struct {
+	Backend  ingressBackend `yaml:"backend"`
+	Path     string         `yaml:"path"`
+	PathType string         `yaml:"pathType,omitempty"`
+}
+
+// ingressBackend holds the service of networking.k8s.io/v1 Ingresses, or the
+// service name and port of older API versions
+type ingressBackend struct {
+	Service     *ingressServiceBackend `yaml:"service,omitempty"`
+	ServiceName string                 `yaml:"serviceName,omitempty"`
+	ServicePort string                 `yaml:"servicePort,omitempty"`
+}
+
+type ingressServiceBackend struct {
+	Name string `yaml:"name"`
+	Port struct {
+		Name string `yaml:"name"`
+	} `yaml:"port"`
+}
+
+type typedConfigMap struct {
+	APIVersion string            `yaml:"apiVersion"`
+	Kind       string            `yaml:"kind"`
+	Metadata   objectMeta        `yaml:"metadata"`
+	Data       map[string]string `yaml:"data"`
+}
+
+type typedPersistentVolumeClaim struct {
+	APIVersion string                    `yaml:"apiVersion"`
+	Kind       string                    `yaml:"kind"`
+	Metadata   objectMeta                `yaml:"metadata"`
+	Spec       persistentVolumeClaimSpec `yaml:"spec"`
+}
+
+type persistentVolumeClaimSpec struct {
+	AccessModes []string `yaml:"accessModes"`
+	Resources   struct {
+		Requests map[string]string `yaml:"requests"`
+	} `yaml:"resources"`
+	StorageClassName string `yaml:"storageClassName,omitempty"`
+}
+
+type typedDeployment struct {
+	APIVersion string         `yaml:"apiVersion"`
+	Kind       string         `yaml:"kind"`
+	Metadata   objectMeta     `yaml:"metadata"`
+	Spec       deploymentSpec `yaml:"spec"`
+}
+
+type deploymentSpec struct {
+	Replicas int64 `yaml:"replicas"`
+	Selector struct {
+		MatchLabels map[string]string `yaml:"matchLabels"`
+	} `yaml:"selector"`
+	Strategy struct {
+		Type string `yaml:"type"`
+	} `yaml:"strategy"`
+	Template struct {
+		Metadata struct {
+			Labels map[string]string `yaml:"labels"`
+		} `yaml:"metadata"`
+		Spec podSpec `yaml:"spec"`
+	} `yaml:"template"`
+}
+
+type podSpec struct {
+	Affinity   podAffinity `yaml:"affinity"`
+	Volumes    []volume    `yaml:"volumes,omitempty"`
+	Containers []container `yaml:"containers"`
+}
+
+type podAffinity struct {
+	PodAntiAffinity struct {
+		PreferredDuringSchedulingIgnoredDuringExecution []weightedPodAffinityTerm `yaml:"preferredDuringSchedulingIgnoredDuringExecution"`
+	} `yaml:"podAntiAffinity"`
+}
+
+type weightedPodAffinityTerm struct {
+	PodAffinityTerm struct {
+		LabelSelector struct {
+			MatchLabels map[string]string `yaml:"matchLabels"`
+		} `yaml:"labelSelector"`
+		TopologyKey string `yaml:"topologyKey"`
+	} `yaml:"podAffinityTerm"`
+	Weight int64 `yaml:"weight"`
+}
+
+type volume struct {
+	Name                  string                             `yaml:"name"`
+	ConfigMap             *configMapVolumeSource             `yaml:"configMap,omitempty"`
+	PersistentVolumeClaim *persistentVolumeClaimVolumeSource `yaml:"persistentVolumeClaim,omitempty"`
+}
+
+type configMapVolumeSource struct {
+	Name string `yaml:"name"`
+}
+
+type persistentVolumeClaimVolumeSource struct {
+	ClaimName string `yaml:"claimName"`
+}
+
+type container struct {
+	Name            string                `yaml:"name"`
+	Image           string                `yaml:"image,omitempty"`
+	ImagePullPolicy string                `yaml:"imagePullPolicy,omitempty"`
+	Command         []string              `yaml:"command,omitempty"`
+	Resources       *resourceRequirements `yaml:"resources,omitempty"`
+	ReadinessProbe  *probe                `yaml:"readinessProbe,omitempty"`
+	LivenessProbe   *probe                `yaml:"livenessProbe,omitempty"`
+	VolumeMounts    []volumeMount         `yaml:"volumeMounts,omitempty"`
+	Ports           []containerPort       `yaml:"ports,omitempty"`
+}
+
+type resourceRequirements struct {
+	Requests map[string]string `yaml:"requests,omitempty"`
+	Limits   map[string]string `yaml:"limits,omitempty"`
+}
+
+type probe struct {
+	HTTPGet             *httpGetAction   `yaml:"httpGet,omitempty"`
+	TCPSocket           *tcpSocketAction `yaml:"tcpSocket,omitempty"`
+	InitialDelaySeconds int64            `yaml:"initialDelaySeconds,omitempty"`
+	PeriodSeconds       int64            `yaml:"periodSeconds,omitempty"`
+}
+
+type httpGetAction struct {
+	Path string `yaml:"path"`
+	Port string `yaml:"port"`
+}
+
+type tcpSocketAction struct {
+	Port string `yaml:"port"`
+}
+
+type volumeMount struct {
+	MountPath string `yaml:"mountPath"`
+	Name      string `yaml:"name"`
+	ReadOnly  bool   `yaml:"readOnly"`
+	SubPath   string `yaml:"subPath,omitempty"`
+}
+
+type containerPort struct {
+	Name          string `yaml:"name"`
+	ContainerPort int64  `yaml:"containerPort"`
+	Protocol      string `yaml:"protocol,omitempty"`
+}
+
+type typedDeploymentPatch struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name string `yaml:"name"`
+	} `yaml:"metadata"`
+	Spec struct {
+		Replicas int64             `yaml:"replicas,omitempty"`
+		Template *podTemplatePatch `yaml:"template,omitempty"`
+	} `yaml:"spec"`
+}
+
+type podTemplatePatch struct {
+	Spec struct {
+		Containers []container `yaml:"containers"`
+	} `yaml:"spec"`
+}
+
+// componentLabels returns the labels of the resources of a component
+func componentLabels(app *models.Application, service *models.Service) map[string]string {
+	return map[string]string{
+		"app":       app.Metadata.Name,
+		"component": service.Name,
+		"release":   app.Metadata.Labels.Version,
+	}
+}
+
+func newTypedService(app *models.Application, service *models.Service) *typedService {
+	s := &typedService{
+		APIVersion: "v1",
+		Kind:       "Service",
+		Metadata:   objectMeta{Name: service.Name, Labels: componentLabels(app, service)},
+		Spec: serviceSpec{
+			Selector: map[string]string{"app": app.Metadata.Name, "component": service.Name},
+			Type:     service.Type,
+		},
+	}
+	for _, port := range service.Ports {
+		s.Spec.Ports = append(s.Spec.Ports, servicePort{
+			Name:       port.Name,
+			Port:       port.Port,
+			Protocol:   port.Protocol,
+			TargetPort: port.TargetPort,
+		})
+	}
+	return s
+}
+
+func newTypedIngress(app *models.Application, apiVersion string, service *models.Service, ingress *models.Ingress) *typedIngress {
+	i := &typedIngress{
+		APIVersion: apiVersion,
+		Kind:       "Ingress",
+		Metadata: objectMeta{
+			Name:        service.Name,
+			Labels:      componentLabels(app, service),
+			Annotations: map[string]string{"kubernetes.io/ingress.class": "nginx"},
+		},
+	}
+
+	// like the template, only the first path of an ingress is rendered
+	path := ingress.Paths[0]
+	rule := ingressRule{Host: ingress.Host}
+	if apiVersion == "networking.k8s.io/v1" {
+		backend := &ingressServiceBackend{Name: service.Name}
+		backend.Port.Name = path.PortName
+		rule.HTTP.Paths = []ingressPath{{Backend: ingressBackend{Service: backend}, Path: path.Path, PathType: "ImplementationSpecific"}}
+	} else {
+		rule.HTTP.Paths = []ingressPath{{Backend: ingressBackend{ServiceName: service.Name, ServicePort: path.PortName}, Path: path.Path}}
+	}
+	i.Spec.Rules = []ingressRule{rule}
+	return i
+}
+
+func newTypedConfigMap(app *models.Application, configMap *models.ConfigMap) *typedConfigMap {
+	return &typedConfigMap{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Metadata: objectMeta{
+			Name:   configMap.Name,
+			Labels: map[string]string{"app": app.Metadata.Name, "release": app.Metadata.Labels.Version},
+		},
+		Data: map[string]string{"data": clip(configMap.Data)},
+	}
+}
+
+func newTypedPersistentVolumeClaim(app *models.Application, persistentVolume *models.PersistentVolume) *typedPersistentVolumeClaim {
+	pvc := &typedPersistentVolumeClaim{
+		APIVersion: "v1",
+		Kind:       "PersistentVolumeClaim",
+		Metadata: objectMeta{
+			Name:   persistentVolume.Name,
+			Labels: map[string]string{"app": app.Metadata.Name, "release": app.Metadata.Labels.Version},
+		},
+	}
+	pvc.Spec.AccessModes = []string{persistentVolume.AccessMode}
+	pvc.Spec.Resources.Requests = map[string]string{"storage": fmt.Sprintf("%dGi", persistentVolume.Capacity)}
+	pvc.Spec.StorageClassName = persistentVolume.StorageClassName
+	return pvc
+}
+
+func newTypedDeployment(app *models.Application, component *models.Component, configMapNames, persistentVolumeNames []string) *typedDeployment {
+	service := component.Service
+	selector := map[string]string{"app": app.Metadata.Name, "component": service.Name}
+
+	d := &typedDeployment{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Metadata:   objectMeta{Name: service.Name, Labels: componentLabels(app, service)},
+	}
+	d.Spec.Replicas = component.Replicas
+	d.Spec.Selector.MatchLabels = selector
+	d.Spec.Strategy.Type = "Recreate"
+	d.Spec.Template.Metadata.Labels = componentLabels(app, service)
+
+	var term weightedPodAffinityTerm
+	term.PodAffinityTerm.LabelSelector.MatchLabels = componentLabels(app, service)
+	term.PodAffinityTerm.TopologyKey = "kubernetes.io/hostname"
+	term.Weight = 100
+	spec := &d.Spec.Template.Spec
+	spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution = []weightedPodAffinityTerm{term}
+
+	for _, name := range configMapNames {
+		spec.Volumes = append(spec.Volumes, volume{Name: name, ConfigMap: &configMapVolumeSource{Name: name}})
+	}
+	for _, name := range persistentVolumeNames {
+		spec.Volumes = append(spec.Volumes, volume{Name: name, PersistentVolumeClaim: &persistentVolumeClaimVolumeSource{ClaimName: name}})
+	}
+
+	for _, c := range component.Containers {
+		spec.Containers = append(spec.Containers, newTypedContainer(service, c))
+	}
+	return d
+}
+
+func newTypedContainer(service *models.Service, c *models.Container) container {
+	result := container{
+		Name:            c.Name,
+		Image:           c.Image + ":" + c.ImageTag,
+		ImagePullPolicy: c.ImagePullPolicy,
+		Resources:       newResourceRequirements(c.Resources),
+		ReadinessProbe:  newProbe(c.ReadinessProbe),
+		LivenessProbe:   newProbe(c.LivenessProbe),
+	}
+	if c.Command != nil && *c.Command != "" {
+		result.Command = commandArgs(*c.Command)
+	}
+
+	for _, v := range c.Volumes {
+		mount := volumeMount{MountPath: v.MountPath, Name: v.Name, ReadOnly: v.ReadOnly}
+		if v.SubPath != nil {
+			mount.SubPath = *v.SubPath
+		}
+		result.VolumeMounts = append(result.VolumeMounts, mount)
+	}
+
+	for _, portName := range c.PortNames {
+		for _, port := range service.Ports {
+			if port.Name != portName {
+				continue
+			}
+			containerPort := containerPort{Name: port.Name, ContainerPort: port.Port, Protocol: port.Protocol}
+			if port.TargetPort != 0 {
+				containerPort.ContainerPort = port.TargetPort
+			}
+			result.Ports = append(result.Ports, containerPort)
+		}
+	}
+	return result
+}
+
+// commandArgs splits the command of a container into its arguments. The
+// command holds the items of a YAML flow sequence, e.g. `"sh", "-c", "run"`,
+// as the template inlines it in one; a command that is not one is kept as a
+// single argument.
+func commandArgs(command string) []string {
+	var args []string
+	if err := yaml.Unmarshal([]byte("["+command+"]"), &args); err != nil || len(args) == 0 {
+		return []string{command}
+	}
+	return args
+}
+
+func newResourceRequirements(resources *models.ResourceRequirements) *resourceRequirements {
+	if resources == nil {
+		return nil
+	}
+	return &resourceRequirements{
+		Requests: newResourceList(resources.Requests),
+		Limits:   newResourceList(resources.Limits),
+	}
+}
+
+func newResourceList(list *models.ResourceList) map[string]string {
+	if list == nil {
+		return nil
+	}
+	resources := map[string]string{}
+	if list.CPU != "" {
+		resources["cpu"] = list.CPU
+	}
+	if list.Memory != "" {
+		resources["memory"] = list.Memory
+	}
+	return resources
+}
+
+// newProbe returns the probe of a container: an HTTP GET of its path when it
+// has one, a TCP connection to its port otherwise
+func newProbe(p *models.Probe) *probe {
+	if p == nil {
+		return nil
+	}
+	result := &probe{InitialDelaySeconds: p.InitialDelaySeconds, PeriodSeconds: p.PeriodSeconds}
+	if p.Path != "" {
+		result.HTTPGet = &httpGetAction{Path: p.Path, Port: p.PortName}
+	} else {
+		result.TCPSocket = &tcpSocketAction{Port: p.PortName}
+	}
+	return result
+}
+
+func newTypedDeploymentPatch(service *models.Service, replicas int64, containers []*models.ContainerOverlay) *typedDeploymentPatch {
+	p := &typedDeploymentPatch{APIVersion: "apps/v1", Kind: "Deployment"}
+	p.Metadata.Name = service.Name
+	p.Spec.Replicas = replicas
+	if len(containers) > 0 {
+		p.Spec.Template = &podTemplatePatch{}
+		for _, c := range containers {
+			p.Spec.Template.Spec.Containers = append(p.Spec.Template.Spec.Containers, container{
+				Name:      c.Name,
+				Resources: newResourceRequirements(c.Resources),
+			})
+		}
+	}
+	return p
+}
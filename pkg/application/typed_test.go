@@ -0,0 +1,278 @@
+package application_test
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"deploy-wizard/gen/models"
+	"deploy-wizard/pkg/application"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// newTypedApplication returns the valid application with the fields only
+// some templates branch on: a command, resources, probes, a sub path and an
+// overlay with container resources
+func newTypedApplication() *models.Application {
+	command := `"sh", "-c", "nginx -g 'daemon off;'"`
+	subPath := "app.yaml"
+
+	component := *validApplication.Spec.Components[0]
+	container := *component.Containers[0]
+	container.Command = &command
+	container.Resources = &models.ResourceRequirements{
+		Requests: &models.ResourceList{CPU: "100m", Memory: "128Mi"},
+		Limits:   &models.ResourceList{Memory: "256Mi"},
+	}
+	container.ReadinessProbe = &models.Probe{PortName: "http", Path: "/healthz", PeriodSeconds: 10}
+	container.LivenessProbe = &models.Probe{PortName: "metrics", InitialDelaySeconds: 15}
+	container.Volumes = []*models.VolumeMount{
+		{MountPath: "/config/app.yaml", Name: "config", SubPath: &subPath, Type: models.VolumeMountTypeConfigMap},
+	}
+	component.Containers = []*models.Container{&container}
+
+	spec := *validApplication.Spec
+	spec.Components = []*models.Component{&component}
+	spec.Overlays = []*models.Overlay{
+		{
+			Env: "Dev",
+			Components: []*models.ComponentOverlay{
+				{
+					Name:     "app1",
+					Replicas: 2,
+					Containers: []*models.ContainerOverlay{
+						{Name: "app1", Resources: &models.ResourceRequirements{Limits: &models.ResourceList{CPU: "500m"}}},
+					},
+				},
+			},
+		},
+	}
+	return application.ApplyDefaults(&models.Application{Metadata: validApplication.Metadata, Spec: &spec})
+}
+
+// decodeManifests decodes the documents of each manifest without their null
+// fields, so that manifests rendered in both modes compare by content
+func decodeManifests(t *testing.T, manifests map[string]string) map[string][]interface{} {
+	decoded := map[string][]interface{}{}
+	for filename, content := range manifests {
+		decoder := yaml.NewDecoder(strings.NewReader(content))
+		for {
+			var doc interface{}
+			err := decoder.Decode(&doc)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("%s: %s\n%s", filename, err, content)
+			}
+			decoded[filename] = append(decoded[filename], dropNulls(doc))
+		}
+	}
+	return decoded
+}
+
+func dropNulls(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[interface{}]interface{}:
+		m := map[interface{}]interface{}{}
+		for k, v := range value {
+			if v != nil {
+				m[k] = dropNulls(v)
+			}
+		}
+		return m
+	case []interface{}:
+		items := make([]interface{}, len(value))
+		for i, v := range value {
+			items[i] = dropNulls(v)
+		}
+		return items
+	}
+	return value
+}
+
+func writeTemplate(t *testing.T, dir, name, text string) {
+	filename := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filename, []byte(text), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func renderManifests(t *testing.T, app *models.Application, opts ...application.RendererOption) map[string]string {
+	renderer, err := application.NewRenderer("", opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifests, err := renderer.RenderManifests(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return manifests
+}
+
+func TestRenderTypedManifests(t *testing.T) {
+	apps := map[string]*models.Application{
+		"valid":       application.ApplyDefaults(validApplication),
+		"typed":       newTypedApplication(),
+		"determinism": newDeterminismApplication(models.DestinationFormatKustomize),
+	}
+
+	for name, app := range apps {
+		expected := decodeManifests(t, renderManifests(t, app))
+		got := decodeManifests(t, renderManifests(t, app, application.WithRenderMode(application.RenderModeTyped)))
+
+		if len(got) != len(expected) {
+			t.Errorf("%s: expected %d manifests, got %d", name, len(expected), len(got))
+		}
+		for filename, docs := range expected {
+			if !reflect.DeepEqual(got[filename], docs) {
+				t.Errorf("%s: %s differs from the template:\nexpected: %v\ngot:      %v", name, filename, docs, got[filename])
+			}
+		}
+	}
+}
+
+func TestRenderTypedManifestsUserInput(t *testing.T) {
+	command := `"sh", "-c", "echo a, b"`
+	data := "key: value\n---\napiVersion: v1\nkind: Secret"
+	ingressPath := "/\n      - path: /admin"
+
+	app := newTypedApplication()
+	app.Spec.ConfigMaps = []*models.ConfigMap{{Name: "config", Data: data}}
+	app.Spec.Components[0].Containers[0].Command = &command
+	app.Spec.Components[0].Ingresses[0] = &models.Ingress{Host: "app1.mc.int", Paths: []*models.IngressPath{{Path: ingressPath, PortName: "http"}}}
+
+	manifests := renderManifests(t, app, application.WithRenderMode(application.RenderModeTyped))
+	for filename, docs := range decodeManifests(t, manifests) {
+		if len(docs) != 1 {
+			t.Errorf("%s: expected a single document, got %d", filename, len(docs))
+		}
+	}
+
+	var configMap struct {
+		Data map[string]string `yaml:"data"`
+	}
+	if err := yaml.Unmarshal([]byte(manifests["base/configmap-config.yaml"]), &configMap); err != nil {
+		t.Fatal(err)
+	}
+	if configMap.Data["data"] != data+"\n" {
+		t.Errorf("expected the ConfigMap data %q, got %q", data+"\n", configMap.Data["data"])
+	}
+
+	var ingress struct {
+		Spec struct {
+			Rules []struct {
+				HTTP struct {
+					Paths []struct {
+						Path string `yaml:"path"`
+					} `yaml:"paths"`
+				} `yaml:"http"`
+			} `yaml:"rules"`
+		} `yaml:"spec"`
+	}
+	if err := yaml.Unmarshal([]byte(manifests["base/ingress-app1.mc.int.yaml"]), &ingress); err != nil {
+		t.Fatal(err)
+	}
+	if paths := ingress.Spec.Rules[0].HTTP.Paths; len(paths) != 1 || paths[0].Path != ingressPath {
+		t.Errorf("expected a single path %q, got %+v", ingressPath, paths)
+	}
+
+	var deployment struct {
+		Spec struct {
+			Template struct {
+				Spec struct {
+					Containers []struct {
+						Command []string `yaml:"command"`
+					} `yaml:"containers"`
+				} `yaml:"spec"`
+			} `yaml:"template"`
+		} `yaml:"spec"`
+	}
+	if err := yaml.Unmarshal([]byte(manifests["base/deployment-app1.yaml"]), &deployment); err != nil {
+		t.Fatal(err)
+	}
+	if args := deployment.Spec.Template.Spec.Containers[0].Command; !reflect.DeepEqual(args, []string{"sh", "-c", "echo a, b"}) {
+		t.Errorf("expected the command split into its arguments, got %q", args)
+	}
+}
+
+func TestRenderTypedManifestsPatchTemplates(t *testing.T) {
+	dir, err := ioutil.TempDir("", "templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeTemplate(t, dir, "patches/deployment.yaml", `metadata:
+  annotations:
+    team: {{.App.Metadata.Labels.Team}}
+spec:
+  strategy: null
+  template:
+    spec:
+      containers:
+      - name: app1
+        env:
+        - name: SERVICE
+          value: {{.Service.Name}}
+`)
+
+	renderer, err := application.NewRenderer(dir, application.WithRenderMode(application.RenderModeTyped))
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifests, err := renderer.RenderManifests(newTypedApplication())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deployment := manifests["base/deployment-app1.yaml"]
+	for _, want := range []string{
+		"  annotations:\n    team: tenant1\n",
+		"  - name: app1\n        image: nginx:alpine\n",
+		"        env:\n        - name: SERVICE\n          value: app1\n",
+	} {
+		if !strings.Contains(deployment, want) {
+			t.Errorf("expected %q in the patched deployment:\n%s", want, deployment)
+		}
+	}
+	if strings.Contains(deployment, "strategy") {
+		t.Errorf("expected the patch to remove the strategy:\n%s", deployment)
+	}
+
+	if service := manifests["base/service-app1.yaml"]; strings.Contains(service, "annotations") {
+		t.Errorf("expected the deployment patch not to apply to the service:\n%s", service)
+	}
+}
+
+func TestRenderTypedManifestsInvalidPatchTemplate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeTemplate(t, dir, "patches/service.yaml", "- {{.Service.Name}}\n")
+
+	renderer, err := application.NewRenderer(dir, application.WithRenderMode(application.RenderModeTyped))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := renderer.RenderManifests(newTypedApplication()); err == nil || !strings.Contains(err.Error(), "patches/service.yaml") {
+		t.Errorf("expected an error for a patch that is not a mapping, got %v", err)
+	}
+}
+
+func TestNewRendererUnknownRenderMode(t *testing.T) {
+	if _, err := application.NewRenderer("", application.WithRenderMode("jsonnet")); err == nil {
+		t.Error("expected an error for an unknown render mode")
+	}
+}
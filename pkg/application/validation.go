@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"deploy-wizard/gen/models"
 	"encoding/json"
-	"fmt"
 	"net"
+	"path"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
+	"unicode"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -18,14 +20,115 @@ import (
 const (
 	errMsgInvalidJSON      = "invalid json payload"
 	errMsgNotAnApplication = "not an application object"
+
+	errMsgDNSLabel        = "%q must be a lowercase RFC 1123 label: at most 63 alphanumerics or '-', starting and ending with an alphanumeric"
+	errMsgTeamProject     = "team %q must name an ArgoCD project: at most 63 alphanumerics or '-', starting and ending with an alphanumeric"
+	errMsgDNS1035Label    = "%q must be a lowercase RFC 1035 label: at most 63 alphanumerics or '-', starting with a letter and ending with an alphanumeric"
+	errMsgIANASvcName     = "%q must be an IANA_SVC_NAME: at most 15 lowercase alphanumerics or '-', with at least one letter and no leading, trailing or double '-'"
+	errMsgLabelValue      = "%q must be a label value: at most 63 alphanumerics, '-', '_' or '.', starting and ending with an alphanumeric"
+	errMsgImage           = "%q must be an image reference without a tag, e.g. registry.mc.int/team/app"
+	errMsgImageTag        = "%q must be an image tag: at most 128 alphanumerics, '_', '.' or '-', not starting with '.' or '-'"
+	errMsgControlChars    = "%q must not contain line breaks or control characters"
+	errMsgIngressPath     = "%q must be an absolute path without whitespace"
+	errMsgRelativeSubPath = "%q must be a relative path without '..'"
+
+	errMsgRequired           = "%q is a required field"
+	errMsgOneOf              = "%q must be one of %s"
+	errMsgPositive           = "%s must be greater than 0"
+	errMsgNegative           = "%s must not be negative"
+	errMsgEmpty              = "%s must not be empty"
+	errMsgName               = "%q must be a valid name"
+	errMsgNamespace          = "%q must be a valid namespace"
+	errMsgHostName           = "%q must be a valid host name"
+	errMsgQuantity           = "%q must be a valid quantity"
+	errMsgDuration           = "%q must be a valid duration"
+	errMsgPortNumber         = "%d is not a valid port number"
+	errMsgDeployTarget       = "%q is not a supported deploy target"
+	errMsgSyncOption         = "%q must be in Key=Value form"
+	errMsgLiteral            = "%q must be a key=value pair"
+	errMsgJSONPointer        = "%q must be a JSON pointer starting with /"
+	errMsgImageOverride      = "one of newName, newTag or digest is required to override %q"
+	errMsgKustomizeHelm      = "kustomize features can not be used with the helm format"
+	errMsgGeneratorSource    = "at least one literal is required"
+	errMsgGeneratorFiles     = "%s are not written to the repository by a release, use literals"
+	errMsgPlaintextSecret    = "secret %q would be committed to the repository in plain text, use a SealedSecret or an ExternalSecret"
+	errMsgDuplicateGenerator = "only one %s named %q can be generated"
+	errMsgDuplicateOverlay   = "only one overlay is allowed for env %q"
+	errMsgUnknownComponent   = "%q must be the name of a component service"
+	errMsgUnknownContainer   = "%q must be the name of a container of component %q"
+	errMsgUnknownIngress     = "%q must be the host of an ingress of component %q"
+	errMsgNoCluster          = "no cluster is configured for region %q and env %q"
+	errMsgFluxCluster        = "flux needs a kubeConfigSecret to deploy to server %q"
+	errMsgNotABranch         = "%q must be a branch a release can commit to, not a tag or a commit"
+
+	errMsgUnknownConfigMap = "%q must be the name of a ConfigMap or ConfigMap generator"
+	errMsgUnknownPV        = "%q must be the name of a PersistentVolume"
+	errMsgUnknownPort      = "%q must be the name of a port of service %q"
+	errMsgNotMounted       = "%q is not mounted by any container"
+	errMsgDuplicate        = "%q is already the name of another %s"
+	errMsgDuplicateVolume  = "%q is already the name of a %s; the volumes of a pod share their names"
+
+	errMsgProbePath            = "%q must be an absolute path without whitespace for the HTTP GET request"
+	errMsgUnknownContainerPort = "%q must be the name of a port of container %q"
 )
 
 var (
-	regexDNSName = regexp.MustCompile(`^([a-zA-Z0-9_]{1}[a-zA-Z0-9_-]{0,62}){1}(\.[a-zA-Z0-9_]{1}[a-zA-Z0-9_-]{0,62})*[\._]?$`)
+	regexDNSName  = regexp.MustCompile(`^([a-zA-Z0-9_]{1}[a-zA-Z0-9_-]{0,62}){1}(\.[a-zA-Z0-9_]{1}[a-zA-Z0-9_-]{0,62})*[\._]?$`)
+	regexQuantity = regexp.MustCompile(`^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$`)
+
+	regexDNSLabel     = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+	regexDNS1035Label = regexp.MustCompile(`^[a-z]([-a-z0-9]*[a-z0-9])?$`)
+	regexIANASvcName  = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+	regexLabelValue   = regexp.MustCompile(`^(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?$`)
+	regexImage        = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9.-]*[a-zA-Z0-9])?(:[0-9]+)?/)?[a-z0-9]+([._-]+[a-z0-9]+)*(/[a-z0-9]+([._-]+[a-z0-9]+)*)*$`)
+	regexImageTag     = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$`)
+
+	envs               = []string{models.LabelsEnvDev, models.LabelsEnvStage, models.LabelsEnvProd}
+	formats            = []string{models.DestinationFormatKustomize, models.DestinationFormatHelm}
+	generatorBehaviors = []string{models.ConfigMapGeneratorBehaviorCreate, models.ConfigMapGeneratorBehaviorReplace, models.ConfigMapGeneratorBehaviorMerge}
 )
 
+// ValidationOption configures ValidateApplication
+type ValidationOption func(*validationOptions)
+
+type validationOptions struct {
+	clusters     Clusters
+	environments Environments
+	policies     Policies
+}
+
+// RequireCluster makes ValidateApplication check that the application's
+// region and env map to one of the configured clusters
+func RequireCluster(clusters Clusters) ValidationOption {
+	return func(o *validationOptions) {
+		o.clusters = clusters
+	}
+}
+
+// EnforcePolicies makes ValidateApplication check the rules of the policies
+// that select the application's env and team
+func EnforcePolicies(policies Policies) ValidationOption {
+	return func(o *validationOptions) {
+		o.policies = policies
+	}
+}
+
+// PromoteWarnings makes CheckApplication report the warnings the env of the
+// application promotes as errors
+func PromoteWarnings(environments Environments) ValidationOption {
+	return func(o *validationOptions) {
+		o.environments = environments
+	}
+}
+
 // ValidateApplication returns of map with key = field and value = error
-func ValidateApplication(appdata interface{}) map[string]interface{} {
+func ValidateApplication(appdata interface{}, opts ...ValidationOption) map[string]interface{} {
+	return CheckApplication(appdata, opts...).Errors
+}
+
+// validateApplication decodes and validates an application. The application
+// is nil if it could not be decoded or misses its metadata or spec.
+func validateApplication(appdata interface{}, options *validationOptions) (*models.Application, map[string]interface{}) {
 	errors := map[string]interface{}{}
 
 	var b bytes.Buffer
@@ -33,29 +136,39 @@ func ValidateApplication(appdata interface{}) map[string]interface{} {
 	err := enc.Encode(appdata)
 	if err != nil {
 		log.WithField("f", "application.ValidateApplication").WithError(err).Warnf(errMsgInvalidJSON)
-		errors[""] = errMsgInvalidJSON
-		return errors
+		errors[""] = newValidationError(errMsgInvalidJSON)
+		return nil, errors
 	}
 
 	var app *models.Application
 	err = json.Unmarshal(b.Bytes(), &app)
 	if err != nil {
 		log.WithField("f", "application.ValidateApplication").WithError(err).Warnf(errMsgNotAnApplication)
-		errors[""] = errMsgNotAnApplication
-		return errors
+		errors[""] = newValidationError(errMsgNotAnApplication)
+		return nil, errors
 	}
 
-	if app.Metadata == nil {
+	if app == nil || app.Metadata == nil {
 		errors[""] = newRequiredValidationError("metadata")
-		return errors
+		return nil, errors
 	}
 
 	if app.Spec == nil {
 		errors[""] = newRequiredValidationError("spec")
-		return errors
+		return nil, errors
 	}
 
 	mdErrors := ValidateMetadata(app.Metadata)
+	if verrs := ValidateCluster(app.Metadata.Labels, options.clusters); len(verrs) > 0 {
+		lblErrors, ok := mdErrors["labels"].(map[string]interface{})
+		if !ok {
+			lblErrors = map[string]interface{}{}
+			mdErrors["labels"] = lblErrors
+		}
+		for field, err := range verrs {
+			lblErrors[field] = err
+		}
+	}
 	if len(mdErrors) > 0 {
 		errors["metadata"] = mdErrors
 	}
@@ -65,7 +178,15 @@ func ValidateApplication(appdata interface{}) map[string]interface{} {
 		errors["spec"] = specErrors
 	}
 
-	return errors
+	// the clusters of an env that deploys with flux are checked with the config,
+	// like the team project of an env whose cluster has no project
+	mergeValidationErrors(errors, ValidateFluxCluster(app, options.clusters))
+	mergeValidationErrors(errors, ValidateTeamProject(app, options.clusters))
+
+	// a field that is invalid on its own is not checked against the policies
+	mergeValidationErrors(errors, ValidatePolicies(app, options.policies.Select(app.Metadata.Labels)))
+
+	return app, errors
 }
 
 // ValidateMetadata returns of map with key = field and value = error
@@ -73,10 +194,14 @@ func ValidateMetadata(md *models.Metadata) map[string]interface{} {
 	errors := map[string]interface{}{}
 	if md.Name == "" {
 		errors["name"] = newRequiredValidationError("name")
+	} else if !isDNSLabel(md.Name) {
+		errors["name"] = newValidationError(errMsgDNSLabel, md.Name)
 	}
 
 	if md.Namespace == "" {
 		errors["namespace"] = newRequiredValidationError("namespace")
+	} else if !isDNSLabel(md.Namespace) {
+		errors["namespace"] = newValidationError(errMsgDNSLabel, md.Namespace)
 	}
 
 	lblErrors := ValidateLabels(md.Labels)
@@ -92,20 +217,84 @@ func ValidateLabels(labels *models.Labels) map[string]interface{} {
 	errors := map[string]interface{}{}
 	if labels.Env == "" {
 		errors["env"] = newRequiredValidationError("env")
+	} else if !isLabelValue(labels.Env) {
+		errors["env"] = newValidationError(errMsgLabelValue, labels.Env)
 	}
 
 	if labels.Team == "" {
 		errors["team"] = newRequiredValidationError("team")
+	} else if !isLabelValue(labels.Team) {
+		errors["team"] = newValidationError(errMsgLabelValue, labels.Team)
 	}
 
 	if labels.Version == "" {
 		errors["version"] = newRequiredValidationError("version")
+	} else if !isLabelValue(labels.Version) {
+		errors["version"] = newValidationError(errMsgLabelValue, labels.Version)
 	}
 
 	if labels.Region == "" {
 		errors["region"] = newRequiredValidationError("region")
+	} else if !isLabelValue(labels.Region) {
+		errors["region"] = newValidationError(errMsgLabelValue, labels.Region)
+	}
+
+	return errors
+}
+
+// ValidateCluster returns of map with key = field and value = error
+func ValidateCluster(labels *models.Labels, clusters Clusters) map[string]interface{} {
+	errors := map[string]interface{}{}
+	if len(clusters) == 0 || labels == nil || labels.Region == "" || labels.Env == "" {
+		// nothing to check or already reported as required
+		return errors
+	}
+
+	if _, err := clusters.Lookup(labels.Region, labels.Env); err != nil {
+		errors["region"] = newValidationError(errMsgNoCluster, labels.Region, labels.Env)
+	}
+
+	return errors
+}
+
+// ValidateFluxCluster checks that Flux can deploy an application that selects
+// the flux deploy target to its cluster
+func ValidateFluxCluster(app *models.Application, clusters Clusters) map[string]interface{} {
+	errors := map[string]interface{}{}
+	labels := app.Metadata.Labels
+	if len(clusters) == 0 || labels == nil || app.Spec.Destination == nil || app.Spec.Destination.DeployTarget != models.DestinationDeployTargetFlux {
+		return errors
+	}
+
+	cluster, err := clusters.Lookup(labels.Region, labels.Env)
+	if err != nil {
+		// already reported by ValidateCluster
+		return errors
+	}
+	if _, ok := cluster.FluxKubeConfigSecret(); !ok {
+		setValidationError(errors, newValidationError(errMsgFluxCluster, cluster.Server), "spec", "destination", "deployTarget")
+	}
+	return errors
+}
+
+// ValidateTeamProject checks that the team of an application names a valid
+// ArgoCD AppProject when a release commits one: ArgoCD deploys it to a
+// cluster without a project of its own
+func ValidateTeamProject(app *models.Application, clusters Clusters) map[string]interface{} {
+	errors := map[string]interface{}{}
+	labels := app.Metadata.Labels
+	if labels == nil || labels.Team == "" || app.Spec.Destination == nil || app.Spec.Destination.DeployTarget == models.DestinationDeployTargetFlux {
+		return errors
 	}
 
+	cluster, err := clusters.Lookup(labels.Region, labels.Env)
+	if err != nil || cluster.Project != "" {
+		// already reported by ValidateCluster, or no team project is committed
+		return errors
+	}
+	if !isDNSLabel(ProjectName(app)) {
+		setValidationError(errors, newValidationError(errMsgTeamProject, labels.Team), "metadata", "labels", "team")
+	}
 	return errors
 }
 
@@ -115,14 +304,148 @@ func ValidateDestination(dest *models.Destination) map[string]interface{} {
 
 	if dest.URL == "" {
 		errors["url"] = newRequiredValidationError("url")
+	} else if hasControlCharacters(string(dest.URL)) || strings.ContainsAny(string(dest.URL), " \t") {
+		errors["url"] = newValidationError(errMsgControlChars, dest.URL)
 	}
 
 	if dest.Path == "" {
 		errors["path"] = newRequiredValidationError("path")
+	} else if hasControlCharacters(dest.Path) {
+		errors["path"] = newValidationError(errMsgControlChars, dest.Path)
 	}
 
 	if dest.TargetRevision == "" {
 		errors["targetRevision"] = newRequiredValidationError("targetRevision")
+	} else if hasControlCharacters(dest.TargetRevision) {
+		errors["targetRevision"] = newValidationError(errMsgControlChars, dest.TargetRevision)
+	}
+
+	if _, ok := deployTargets[dest.DeployTarget]; dest.DeployTarget != "" && !ok {
+		errors["deployTarget"] = newValidationError(errMsgDeployTarget, dest.DeployTarget)
+	}
+
+	if dest.Format != "" && !containsString(formats, dest.Format) {
+		errors["format"] = newValidationError(errMsgOneOf, dest.Format, strings.Join(formats, ", "))
+	}
+
+	if dest.SyncPolicy != nil {
+		if verrs := ValidateSyncPolicy(dest.SyncPolicy); len(verrs) > 0 {
+			errors["syncPolicy"] = verrs
+		}
+	}
+
+	if verrs := ValidateIgnoreDifferences(dest.IgnoreDifferences); len(verrs) > 0 {
+		errors["ignoreDifferences"] = verrs
+	}
+
+	finalizerErrors := map[string]interface{}{}
+	for i, finalizer := range dest.Finalizers {
+		if finalizer == "" {
+			finalizerErrors[strconv.Itoa(i)] = newValidationError(errMsgEmpty, "finalizer")
+		} else if hasControlCharacters(finalizer) {
+			finalizerErrors[strconv.Itoa(i)] = newValidationError(errMsgControlChars, finalizer)
+		}
+	}
+	if len(finalizerErrors) > 0 {
+		errors["finalizers"] = finalizerErrors
+	}
+
+	return errors
+}
+
+// RequiredErrors returns the validation errors of a request without a field
+func RequiredErrors(field string) map[string]interface{} {
+	return map[string]interface{}{field: newRequiredValidationError(field)}
+}
+
+// NotABranchErrors returns the validation errors of a release whose target
+// revision the repository has as a tag or a commit, which can not be pushed to
+func NotABranchErrors(dest *models.Destination) map[string]interface{} {
+	errors := map[string]interface{}{}
+	setValidationError(errors, newValidationError(errMsgNotABranch, dest.TargetRevision), "spec", "destination", "targetRevision")
+	return errors
+}
+
+// ValidateSyncPolicy returns of map with key = field and value = error
+func ValidateSyncPolicy(syncPolicy *models.SyncPolicy) map[string]interface{} {
+	errors := map[string]interface{}{}
+
+	optionErrors := map[string]interface{}{}
+	for i, option := range syncPolicy.SyncOptions {
+		if parts := strings.SplitN(option, "=", 2); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			optionErrors[strconv.Itoa(i)] = newValidationError(errMsgSyncOption, option)
+		} else if hasControlCharacters(option) {
+			optionErrors[strconv.Itoa(i)] = newValidationError(errMsgControlChars, option)
+		}
+	}
+	if len(optionErrors) > 0 {
+		errors["syncOptions"] = optionErrors
+	}
+
+	if retry := syncPolicy.Retry; retry != nil {
+		retryErrors := map[string]interface{}{}
+		if retry.Limit < 0 {
+			retryErrors["limit"] = newValidationError(errMsgNegative, "limit")
+		}
+		if backoff := retry.Backoff; backoff != nil {
+			backoffErrors := map[string]interface{}{}
+			if backoff.Duration != "" && !isValidDuration(backoff.Duration) {
+				backoffErrors["duration"] = newValidationError(errMsgDuration, backoff.Duration)
+			}
+			if backoff.MaxDuration != "" && !isValidDuration(backoff.MaxDuration) {
+				backoffErrors["maxDuration"] = newValidationError(errMsgDuration, backoff.MaxDuration)
+			}
+			if backoff.Factor < 0 {
+				backoffErrors["factor"] = newValidationError(errMsgNegative, "factor")
+			}
+			if len(backoffErrors) > 0 {
+				retryErrors["backoff"] = backoffErrors
+			}
+		}
+		if len(retryErrors) > 0 {
+			errors["retry"] = retryErrors
+		}
+	}
+
+	return errors
+}
+
+// ValidateIgnoreDifferences returns of map with key = field and value = error
+func ValidateIgnoreDifferences(ignoreDifferences []*models.IgnoreDifference) map[string]interface{} {
+	errors := map[string]interface{}{}
+	for i, ignoreDifference := range ignoreDifferences {
+		if verrs := ValidateIgnoreDifference(ignoreDifference); len(verrs) > 0 {
+			errors[strconv.Itoa(i)] = verrs
+		}
+	}
+	return errors
+}
+
+// ValidateIgnoreDifference returns of map with key = field and value = error
+func ValidateIgnoreDifference(ignoreDifference *models.IgnoreDifference) map[string]interface{} {
+	errors := map[string]interface{}{}
+
+	if ignoreDifference.Kind == "" {
+		errors["kind"] = newRequiredValidationError("kind")
+	} else if hasControlCharacters(ignoreDifference.Kind) {
+		errors["kind"] = newValidationError(errMsgControlChars, ignoreDifference.Kind)
+	}
+
+	if len(ignoreDifference.JSONPointers) == 0 {
+		errors["jsonPointers"] = newRequiredValidationError("jsonPointers")
+		return errors
+	}
+
+	pointerErrors := map[string]interface{}{}
+	for i, pointer := range ignoreDifference.JSONPointers {
+		if !strings.HasPrefix(pointer, "/") {
+			pointerErrors[strconv.Itoa(i)] = newValidationError(errMsgJSONPointer, pointer)
+		} else if hasControlCharacters(pointer) {
+			pointerErrors[strconv.Itoa(i)] = newValidationError(errMsgControlChars, pointer)
+		}
+	}
+	if len(pointerErrors) > 0 {
+		errors["jsonPointers"] = pointerErrors
 	}
 
 	return errors
@@ -154,10 +477,434 @@ func ValidateSpec(spec *models.Spec) map[string]interface{} {
 	if verrs := ValidatePersistentVolumes(spec.PersistentVolumes); len(verrs) > 0 {
 		errors["persistentVolumes"] = verrs
 	}
+	if verrs := ValidateOverlays(spec.Overlays, spec.Components); len(verrs) > 0 {
+		errors["overlays"] = verrs
+	}
+	if spec.Kustomize != nil && spec.Destination != nil && spec.Destination.Format == models.DestinationFormatHelm {
+		errors["kustomize"] = newValidationError(errMsgKustomizeHelm)
+	} else if spec.Kustomize != nil {
+		if verrs := ValidateKustomize(spec.Kustomize); len(verrs) > 0 {
+			errors["kustomize"] = verrs
+		}
+	}
+
+	// a field that is invalid on its own is not checked for its references
+	mergeValidationErrors(errors, ValidateReferences(spec))
+
+	return errors
+}
+
+// ValidateReferences returns of map with key = field and value = error for
+// the references between the parts of an application: the ConfigMaps and
+// PersistentVolumes its containers mount, the service ports its containers
+// and ingresses name, the container ports its probes check, the ConfigMaps
+// and PersistentVolumes no container mounts and the names used more than
+// once.
+func ValidateReferences(spec *models.Spec) map[string]interface{} {
+	errors := map[string]interface{}{}
+
+	configMaps := map[string]bool{}
+	persistentVolumes := map[string]bool{}
+	for i, configMap := range spec.ConfigMaps {
+		if configMaps[configMap.Name] {
+			setValidationError(errors, newValidationError(errMsgDuplicate, configMap.Name, "ConfigMap"), "configMaps", strconv.Itoa(i), "name")
+		}
+		configMaps[configMap.Name] = true
+	}
+	for i, persistentVolume := range spec.PersistentVolumes {
+		if persistentVolumes[persistentVolume.Name] {
+			setValidationError(errors, newValidationError(errMsgDuplicate, persistentVolume.Name, "PersistentVolume"), "persistentVolumes", strconv.Itoa(i), "name")
+		} else if configMaps[persistentVolume.Name] {
+			setValidationError(errors, newValidationError(errMsgDuplicateVolume, persistentVolume.Name, "ConfigMap"), "persistentVolumes", strconv.Itoa(i), "name")
+		}
+		persistentVolumes[persistentVolume.Name] = true
+	}
+
+	generated := map[string]bool{}
+	if spec.Kustomize != nil {
+		for _, generator := range spec.Kustomize.ConfigMapGenerator {
+			generated[generator.Name] = true
+		}
+	}
+
+	mounted := map[string]bool{}
+	services := map[string]bool{}
+	for i, component := range spec.Components {
+		componentPath := []string{"components", strconv.Itoa(i)}
+		if component.Service == nil {
+			continue
+		}
+
+		if services[component.Service.Name] {
+			setValidationError(errors, newValidationError(errMsgDuplicate, component.Service.Name, "component service"), append(componentPath, "service", "name")...)
+		}
+		services[component.Service.Name] = true
+
+		ports := map[string]bool{}
+		for j, port := range component.Service.Ports {
+			if ports[port.Name] {
+				setValidationError(errors, newValidationError(errMsgDuplicate, port.Name, "port of the service"), append(componentPath, "service", "ports", strconv.Itoa(j), "name")...)
+			}
+			ports[port.Name] = true
+		}
+
+		containers := map[string]bool{}
+		for j, container := range component.Containers {
+			containerPath := append(componentPath, "containers", strconv.Itoa(j))
+
+			if containers[container.Name] {
+				setValidationError(errors, newValidationError(errMsgDuplicate, container.Name, "container of the component"), append(containerPath, "name")...)
+			}
+			containers[container.Name] = true
+
+			for k, portName := range container.PortNames {
+				if !ports[portName] {
+					setValidationError(errors, newValidationError(errMsgUnknownPort, portName, component.Service.Name), append(containerPath, "portNames", strconv.Itoa(k))...)
+				}
+			}
+
+			probes := map[string]*models.Probe{"readinessProbe": container.ReadinessProbe, "livenessProbe": container.LivenessProbe}
+			for field, probe := range probes {
+				if probe != nil && !containsString(container.PortNames, probe.PortName) {
+					setValidationError(errors, newValidationError(errMsgUnknownContainerPort, probe.PortName, container.Name), append(containerPath, field, "portName")...)
+				}
+			}
+
+			mountPaths := map[string]bool{}
+			for k, volume := range container.Volumes {
+				volumePath := append(containerPath, "volumes", strconv.Itoa(k))
+
+				mounted[volume.Type+"/"+volume.Name] = true
+				switch volume.Type {
+				case models.VolumeMountTypeConfigMap:
+					if !configMaps[volume.Name] && !generated[volume.Name] {
+						setValidationError(errors, newValidationError(errMsgUnknownConfigMap, volume.Name), append(volumePath, "name")...)
+					}
+				case models.VolumeMountTypePersistentVolume:
+					if !persistentVolumes[volume.Name] {
+						setValidationError(errors, newValidationError(errMsgUnknownPV, volume.Name), append(volumePath, "name")...)
+					}
+				}
+
+				if mountPaths[volume.MountPath] {
+					setValidationError(errors, newValidationError(errMsgDuplicate, volume.MountPath, "volume mount of the container"), append(volumePath, "mountPath")...)
+				}
+				mountPaths[volume.MountPath] = true
+			}
+		}
+
+		for j, ingress := range component.Ingresses {
+			for k, ingressPath := range ingress.Paths {
+				if !ports[ingressPath.PortName] {
+					setValidationError(errors, newValidationError(errMsgUnknownPort, ingressPath.PortName, component.Service.Name),
+						append(componentPath, "ingresses", strconv.Itoa(j), "paths", strconv.Itoa(k), "portName")...)
+				}
+			}
+		}
+	}
+
+	for i, configMap := range spec.ConfigMaps {
+		if !mounted[models.VolumeMountTypeConfigMap+"/"+configMap.Name] {
+			setValidationError(errors, newValidationError(errMsgNotMounted, configMap.Name), "configMaps", strconv.Itoa(i), "name")
+		}
+	}
+	for i, persistentVolume := range spec.PersistentVolumes {
+		if !mounted[models.VolumeMountTypePersistentVolume+"/"+persistentVolume.Name] {
+			setValidationError(errors, newValidationError(errMsgNotMounted, persistentVolume.Name), "persistentVolumes", strconv.Itoa(i), "name")
+		}
+	}
+
+	return errors
+}
+
+// setValidationError sets the error of the field at the path of keys, unless
+// the field already has one
+func setValidationError(errors map[string]interface{}, message validationError, keys ...string) {
+	for _, key := range keys[:len(keys)-1] {
+		nested, ok := errors[key].(map[string]interface{})
+		if !ok {
+			if _, exists := errors[key]; exists {
+				return
+			}
+			nested = map[string]interface{}{}
+			errors[key] = nested
+		}
+		errors = nested
+	}
+	if _, exists := errors[keys[len(keys)-1]]; !exists {
+		errors[keys[len(keys)-1]] = message
+	}
+}
+
+// mergeValidationErrors adds the errors of src to dst, keeping the errors dst
+// already has for a field
+func mergeValidationErrors(dst, src map[string]interface{}) {
+	for key, value := range src {
+		nestedSrc, srcOk := value.(map[string]interface{})
+		nestedDst, dstOk := dst[key].(map[string]interface{})
+		switch {
+		case srcOk && dstOk:
+			mergeValidationErrors(nestedDst, nestedSrc)
+		case dst[key] == nil:
+			dst[key] = value
+		}
+	}
+}
+
+// ValidateKustomize returns of map with key = field and value = error
+func ValidateKustomize(kustomize *models.Kustomize) map[string]interface{} {
+	errors := map[string]interface{}{}
+
+	if kustomize.Namespace != "" && !isValidDNSName(kustomize.Namespace) {
+		errors["namespace"] = newValidationError(errMsgNamespace, kustomize.Namespace)
+	}
+	if hasControlCharacters(kustomize.NamePrefix) {
+		errors["namePrefix"] = newValidationError(errMsgControlChars, kustomize.NamePrefix)
+	}
+	if hasControlCharacters(kustomize.NameSuffix) {
+		errors["nameSuffix"] = newValidationError(errMsgControlChars, kustomize.NameSuffix)
+	}
+	if verrs := validateStringMap(kustomize.CommonLabels); len(verrs) > 0 {
+		errors["commonLabels"] = verrs
+	}
+	if verrs := validateStringMap(kustomize.CommonAnnotations); len(verrs) > 0 {
+		errors["commonAnnotations"] = verrs
+	}
+
+	imageErrors := map[string]interface{}{}
+	for i, image := range kustomize.Images {
+		if image.Name == "" {
+			imageErrors[strconv.Itoa(i)] = map[string]interface{}{"name": newRequiredValidationError("name")}
+		} else if image.NewName == "" && image.NewTag == "" && image.Digest == "" {
+			imageErrors[strconv.Itoa(i)] = map[string]interface{}{"name": newValidationError(errMsgImageOverride, image.Name)}
+		} else if verrs := validateKustomizeImage(image); len(verrs) > 0 {
+			imageErrors[strconv.Itoa(i)] = verrs
+		}
+	}
+	if len(imageErrors) > 0 {
+		errors["images"] = imageErrors
+	}
+
+	generatorErrors := map[string]interface{}{}
+	seen := map[string]struct{}{}
+	for i, generator := range kustomize.ConfigMapGenerator {
+		errs := ValidateGenerator(generator.Name, generator.Behavior, generator.Literals, generator.Files, generator.Envs)
+		if _, ok := seen[generator.Name]; ok {
+			errs["name"] = newValidationError(errMsgDuplicateGenerator, "ConfigMap", generator.Name)
+		}
+		seen[generator.Name] = struct{}{}
+		if len(errs) > 0 {
+			generatorErrors[strconv.Itoa(i)] = errs
+		}
+	}
+	if len(generatorErrors) > 0 {
+		errors["configMapGenerator"] = generatorErrors
+	}
+
+	// a release commits the literals of a Secret generator to the repository
+	generatorErrors = map[string]interface{}{}
+	for i, generator := range kustomize.SecretGenerator {
+		generatorErrors[strconv.Itoa(i)] = newValidationError(errMsgPlaintextSecret, generator.Name)
+	}
+	if len(generatorErrors) > 0 {
+		errors["secretGenerator"] = generatorErrors
+	}
+
+	return errors
+}
+
+// ValidateGenerator returns of map with key = field and value = error
+func ValidateGenerator(name, behavior string, literals, files, envs []string) map[string]interface{} {
+	errors := map[string]interface{}{}
+
+	if name == "" {
+		errors["name"] = newRequiredValidationError("name")
+	} else if !isValidDNSName(name) {
+		errors["name"] = newValidationError(errMsgName, name)
+	}
+
+	if behavior != "" && !containsString(generatorBehaviors, behavior) {
+		errors["behavior"] = newValidationError(errMsgOneOf, behavior, strings.Join(generatorBehaviors, ", "))
+	}
+
+	if len(literals) == 0 && len(files) == 0 && len(envs) == 0 {
+		errors["literals"] = newValidationError(errMsgGeneratorSource)
+	}
+
+	// a release only writes the rendered manifests, so kustomize build would
+	// not find the files
+	if len(files) > 0 {
+		errors["files"] = newValidationError(errMsgGeneratorFiles, "files")
+	}
+	if len(envs) > 0 {
+		errors["envs"] = newValidationError(errMsgGeneratorFiles, "envs")
+	}
+
+	literalErrors := map[string]interface{}{}
+	for i, literal := range literals {
+		if !strings.Contains(literal, "=") || strings.HasPrefix(literal, "=") {
+			literalErrors[strconv.Itoa(i)] = newValidationError(errMsgLiteral, literal)
+		} else if hasControlCharacters(literal) {
+			literalErrors[strconv.Itoa(i)] = newValidationError(errMsgControlChars, literal)
+		}
+	}
+	if len(literalErrors) > 0 {
+		errors["literals"] = literalErrors
+	}
+
+	return errors
+}
+
+func validateKustomizeImage(image *models.KustomizeImage) map[string]interface{} {
+	errors := map[string]interface{}{}
+	if !regexImage.MatchString(image.Name) {
+		errors["name"] = newValidationError(errMsgImage, image.Name)
+	}
+	if image.NewName != "" && !regexImage.MatchString(image.NewName) {
+		errors["newName"] = newValidationError(errMsgImage, image.NewName)
+	}
+	if image.NewTag != "" && !regexImageTag.MatchString(image.NewTag) {
+		errors["newTag"] = newValidationError(errMsgImageTag, image.NewTag)
+	}
+	if hasControlCharacters(image.Digest) || strings.Contains(image.Digest, " ") {
+		errors["digest"] = newValidationError(errMsgControlChars, image.Digest)
+	}
+	return errors
+}
+
+// ValidateOverlays returns of map with key = field and value = error
+func ValidateOverlays(overlays []*models.Overlay, components []*models.Component) map[string]interface{} {
+	errors := map[string]interface{}{}
+	seen := map[string]struct{}{}
+	for i, overlay := range overlays {
+		errs := ValidateOverlay(overlay, components)
+		if _, ok := seen[strings.ToLower(overlay.Env)]; ok && overlay.Env != "" {
+			errs["env"] = newValidationError(errMsgDuplicateOverlay, overlay.Env)
+		}
+		seen[strings.ToLower(overlay.Env)] = struct{}{}
+		if len(errs) > 0 {
+			errors[strconv.Itoa(i)] = errs
+		}
+	}
+	return errors
+}
+
+// ValidateOverlay returns of map with key = field and value = error
+func ValidateOverlay(overlay *models.Overlay, components []*models.Component) map[string]interface{} {
+	errors := map[string]interface{}{}
+
+	if overlay.Env == "" {
+		errors["env"] = newRequiredValidationError("env")
+	} else if !containsFold(envs, overlay.Env) {
+		errors["env"] = newValidationError(errMsgOneOf, overlay.Env, strings.Join(envs, ", "))
+	}
+
+	componentErrors := map[string]interface{}{}
+	for i, componentOverlay := range overlay.Components {
+		var component *models.Component
+		for _, c := range components {
+			if c.Service != nil && c.Service.Name == componentOverlay.Name {
+				component = c
+			}
+		}
+		if verrs := ValidateComponentOverlay(componentOverlay, component); len(verrs) > 0 {
+			componentErrors[strconv.Itoa(i)] = verrs
+		}
+	}
+	if len(componentErrors) > 0 {
+		errors["components"] = componentErrors
+	}
 
 	return errors
 }
 
+// ValidateComponentOverlay returns of map with key = field and value = error
+func ValidateComponentOverlay(componentOverlay *models.ComponentOverlay, component *models.Component) map[string]interface{} {
+	errors := map[string]interface{}{}
+
+	if componentOverlay.Name == "" {
+		errors["name"] = newRequiredValidationError("name")
+		return errors
+	}
+	if component == nil {
+		errors["name"] = newValidationError(errMsgUnknownComponent, componentOverlay.Name)
+		return errors
+	}
+
+	if componentOverlay.Replicas < 0 {
+		errors["replicas"] = newValidationError(errMsgNegative, "replicas")
+	}
+
+	containerErrors := map[string]interface{}{}
+	for i, containerOverlay := range componentOverlay.Containers {
+		verrs := map[string]interface{}{}
+		if findContainer(component, containerOverlay.Name) == nil {
+			verrs["name"] = newValidationError(errMsgUnknownContainer, containerOverlay.Name, component.Service.Name)
+		}
+		if containerOverlay.ImageTag != "" && !regexImageTag.MatchString(containerOverlay.ImageTag) {
+			verrs["imageTag"] = newValidationError(errMsgImageTag, containerOverlay.ImageTag)
+		}
+		if containerOverlay.Resources != nil {
+			if rerrs := ValidateResourceRequirements(containerOverlay.Resources); len(rerrs) > 0 {
+				verrs["resources"] = rerrs
+			}
+		}
+		if len(verrs) > 0 {
+			containerErrors[strconv.Itoa(i)] = verrs
+		}
+	}
+	if len(containerErrors) > 0 {
+		errors["containers"] = containerErrors
+	}
+
+	ingressErrors := map[string]interface{}{}
+	for i, ingressOverlay := range componentOverlay.Ingresses {
+		verrs := map[string]interface{}{}
+		if findIngress(component, ingressOverlay.Host) == nil {
+			verrs["host"] = newValidationError(errMsgUnknownIngress, ingressOverlay.Host, component.Service.Name)
+		}
+		if !isValidDNSName(ingressOverlay.NewHost) {
+			verrs["newHost"] = newValidationError(errMsgHostName, ingressOverlay.NewHost)
+		}
+		if len(verrs) > 0 {
+			ingressErrors[strconv.Itoa(i)] = verrs
+		}
+	}
+	if len(ingressErrors) > 0 {
+		errors["ingresses"] = ingressErrors
+	}
+
+	return errors
+}
+
+// ValidateResourceRequirements returns of map with key = field and value = error
+func ValidateResourceRequirements(resources *models.ResourceRequirements) map[string]interface{} {
+	errors := map[string]interface{}{}
+	if resources.Requests != nil {
+		if verrs := ValidateResourceList(resources.Requests); len(verrs) > 0 {
+			errors["requests"] = verrs
+		}
+	}
+	if resources.Limits != nil {
+		if verrs := ValidateResourceList(resources.Limits); len(verrs) > 0 {
+			errors["limits"] = verrs
+		}
+	}
+	return errors
+}
+
+// ValidateResourceList returns of map with key = field and value = error
+func ValidateResourceList(resources *models.ResourceList) map[string]interface{} {
+	errors := map[string]interface{}{}
+	if resources.CPU != "" && !regexQuantity.MatchString(resources.CPU) {
+		errors["cpu"] = newValidationError(errMsgQuantity, resources.CPU)
+	}
+	if resources.Memory != "" && !regexQuantity.MatchString(resources.Memory) {
+		errors["memory"] = newValidationError(errMsgQuantity, resources.Memory)
+	}
+	return errors
+}
+
 // ValidateConfigMaps returns of map with key = field and value = error
 func ValidateConfigMaps(configMaps []*models.ConfigMap) map[string]interface{} {
 	errors := map[string]interface{}{}
@@ -177,6 +924,8 @@ func ValidateConfigMap(configMap *models.ConfigMap) map[string]interface{} {
 
 	if configMap.Name == "" {
 		errors["name"] = newRequiredValidationError("name")
+	} else if !isDNSLabel(configMap.Name) {
+		errors["name"] = newValidationError(errMsgDNSLabel, configMap.Name)
 	}
 
 	if configMap.Data == "" {
@@ -205,6 +954,8 @@ func ValidatePersistentVolume(persistentVolume *models.PersistentVolume) map[str
 
 	if persistentVolume.Name == "" {
 		errors["name"] = newRequiredValidationError("name")
+	} else if !isDNSLabel(persistentVolume.Name) {
+		errors["name"] = newValidationError(errMsgDNSLabel, persistentVolume.Name)
 	}
 
 	if persistentVolume.AccessMode == "" {
@@ -212,11 +963,13 @@ func ValidatePersistentVolume(persistentVolume *models.PersistentVolume) map[str
 	}
 
 	if persistentVolume.Capacity <= 0 {
-		errors["capacity"] = "capacity must be greater than 0"
+		errors["capacity"] = newValidationError(errMsgPositive, "capacity")
 	}
 
 	if persistentVolume.StorageClassName == "" {
 		errors["storageClassName"] = newRequiredValidationError("storageClassName")
+	} else if hasControlCharacters(persistentVolume.StorageClassName) || strings.ContainsAny(persistentVolume.StorageClassName, " \t") {
+		errors["storageClassName"] = newValidationError(errMsgControlChars, persistentVolume.StorageClassName)
 	}
 
 	return errors
@@ -238,6 +991,10 @@ func ValidateComponent(component *models.Component) map[string]interface{} {
 		errors["containers"] = verrs
 	}
 
+	if component.Replicas < 0 {
+		errors["replicas"] = newValidationError(errMsgNegative, "replicas")
+	}
+
 	return errors
 }
 
@@ -260,6 +1017,8 @@ func ValidateService(svc *models.Service) map[string]interface{} {
 
 	if svc.Name == "" {
 		errors["name"] = newRequiredValidationError("name")
+	} else if !isDNS1035Label(svc.Name) {
+		errors["name"] = newValidationError(errMsgDNS1035Label, svc.Name)
 	}
 
 	if svc.Type == "" {
@@ -300,26 +1059,87 @@ func ValidateContainer(container *models.Container) map[string]interface{} {
 
 	if container.Name == "" {
 		errors["name"] = newRequiredValidationError("name")
+	} else if !isDNSLabel(container.Name) {
+		errors["name"] = newValidationError(errMsgDNSLabel, container.Name)
 	}
 
 	if container.Image == "" {
 		errors["image"] = newRequiredValidationError("image")
+	} else if !regexImage.MatchString(container.Image) {
+		errors["image"] = newValidationError(errMsgImage, container.Image)
 	}
 
 	if container.ImageTag == "" {
 		errors["imageTag"] = newRequiredValidationError("imageTag")
+	} else if !regexImageTag.MatchString(container.ImageTag) {
+		errors["imageTag"] = newValidationError(errMsgImageTag, container.ImageTag)
 	}
 
 	if len(container.PortNames) == 0 {
 		errors["portNames"] = newRequiredValidationError("portNames")
 	}
 
+	portNameErrors := map[string]interface{}{}
+	for i, portName := range container.PortNames {
+		if !isIANASvcName(portName) {
+			portNameErrors[strconv.Itoa(i)] = newValidationError(errMsgIANASvcName, portName)
+		}
+	}
+	if len(portNameErrors) > 0 {
+		errors["portNames"] = portNameErrors
+	}
+
+	if container.Command != nil && hasControlCharacters(*container.Command) {
+		errors["command"] = newValidationError(errMsgControlChars, *container.Command)
+	}
+
 	if len(container.Volumes) > 0 {
 		if verrs := ValidateVolumeMounts(container.Volumes); len(verrs) > 0 {
 			errors["volumes"] = verrs
 		}
 	}
 
+	if container.Resources != nil {
+		if verrs := ValidateResourceRequirements(container.Resources); len(verrs) > 0 {
+			errors["resources"] = verrs
+		}
+	}
+
+	if container.ReadinessProbe != nil {
+		if verrs := ValidateProbe(container.ReadinessProbe); len(verrs) > 0 {
+			errors["readinessProbe"] = verrs
+		}
+	}
+	if container.LivenessProbe != nil {
+		if verrs := ValidateProbe(container.LivenessProbe); len(verrs) > 0 {
+			errors["livenessProbe"] = verrs
+		}
+	}
+
+	return errors
+}
+
+// ValidateProbe returns of map with key = field and value = error
+func ValidateProbe(probe *models.Probe) map[string]interface{} {
+	errors := map[string]interface{}{}
+
+	if probe.PortName == "" {
+		errors["portName"] = newRequiredValidationError("portName")
+	} else if !isIANASvcName(probe.PortName) {
+		errors["portName"] = newValidationError(errMsgIANASvcName, probe.PortName)
+	}
+
+	if probe.Path != "" && (!strings.HasPrefix(probe.Path, "/") || hasControlCharacters(probe.Path) || strings.ContainsAny(probe.Path, " \t")) {
+		errors["path"] = newValidationError(errMsgProbePath, probe.Path)
+	}
+
+	if probe.InitialDelaySeconds < 0 {
+		errors["initialDelaySeconds"] = newValidationError(errMsgNegative, "initialDelaySeconds")
+	}
+	if probe.PeriodSeconds < 0 {
+		errors["periodSeconds"] = newValidationError(errMsgNegative, "periodSeconds")
+	}
+
 	return errors
 }
 
@@ -339,12 +1159,23 @@ func ValidateVolumeMount(mount *models.VolumeMount) map[string]interface{} {
 	errors := map[string]interface{}{}
 	if mount.Name == "" {
 		errors["name"] = newRequiredValidationError("name")
+	} else if !isDNSLabel(mount.Name) {
+		errors["name"] = newValidationError(errMsgDNSLabel, mount.Name)
 	}
 	if mount.Type == "" {
 		errors["type"] = newRequiredValidationError("type")
 	}
 	if mount.MountPath == "" {
 		errors["mountPath"] = newRequiredValidationError("mountPath")
+	} else if hasControlCharacters(mount.MountPath) {
+		errors["mountPath"] = newValidationError(errMsgControlChars, mount.MountPath)
+	}
+	if subPath := mount.SubPath; subPath != nil && *subPath != "" {
+		if hasControlCharacters(*subPath) {
+			errors["subPath"] = newValidationError(errMsgControlChars, *subPath)
+		} else if path.IsAbs(*subPath) || containsString(strings.Split(*subPath, "/"), "..") {
+			errors["subPath"] = newValidationError(errMsgRelativeSubPath, *subPath)
+		}
 	}
 	return errors
 }
@@ -370,10 +1201,12 @@ func ValidateServicePort(port *models.ServicePort) map[string]interface{} {
 
 	if port.Name == "" {
 		errors["name"] = newRequiredValidationError("name")
+	} else if !isIANASvcName(port.Name) {
+		errors["name"] = newValidationError(errMsgIANASvcName, port.Name)
 	}
 
 	if port.Port == 0 {
-		errors["port"] = fmt.Sprintf("%d is not a valid port number", port.Port)
+		errors["port"] = newValidationError(errMsgPortNumber, port.Port)
 	}
 
 	return errors
@@ -399,10 +1232,13 @@ func ValidateIngress(ingress *models.Ingress) map[string]interface{} {
 	}
 
 	if !isValidDNSName(ingress.Host) {
-		errors["host"] = fmt.Sprintf("%q must be a valid host name", ingress.Host)
+		errors["host"] = newValidationError(errMsgHostName, ingress.Host)
 	}
 
-	if verrs := ValidateIngressPaths(ingress.Paths); len(verrs) > 0 {
+	// the rules of an ingress are rendered for its first path
+	if len(ingress.Paths) == 0 {
+		errors["paths"] = newRequiredValidationError("paths")
+	} else if verrs := ValidateIngressPaths(ingress.Paths); len(verrs) > 0 {
 		errors["paths"] = verrs
 	}
 
@@ -431,19 +1267,21 @@ func ValidateIngressPath(ingressPath *models.IngressPath) map[string]interface{}
 
 	if ingressPath.Path == "" {
 		errors["path"] = newRequiredValidationError("path")
+	} else if !strings.HasPrefix(ingressPath.Path, "/") || hasControlCharacters(ingressPath.Path) || strings.ContainsAny(ingressPath.Path, " \t") {
+		errors["path"] = newValidationError(errMsgIngressPath, ingressPath.Path)
 	}
 
 	if ingressPath.PortName == "" {
 		errors["portName"] = newRequiredValidationError("portName")
+	} else if !isIANASvcName(ingressPath.PortName) {
+		errors["portName"] = newValidationError(errMsgIANASvcName, ingressPath.PortName)
 	}
 
-	// TODO: portName matches one of service port names
-
 	return errors
 }
 
-func newRequiredValidationError(field string) string {
-	return fmt.Sprintf("%q is a required field", field)
+func newRequiredValidationError(field string) validationError {
+	return newValidationError(errMsgRequired, field)
 }
 
 func isValidDNSName(host string) bool {
@@ -454,6 +1292,68 @@ func isValidDNSName(host string) bool {
 	return !isIP(host) && regexDNSName.MatchString(host)
 }
 
+// isDNSLabel reports whether s is an RFC 1123 label, the format of most
+// Kubernetes object names
+func isDNSLabel(s string) bool {
+	return len(s) <= 63 && regexDNSLabel.MatchString(s)
+}
+
+// isDNS1035Label reports whether s is an RFC 1035 label, the format of
+// Service names
+func isDNS1035Label(s string) bool {
+	return len(s) <= 63 && regexDNS1035Label.MatchString(s)
+}
+
+// isIANASvcName reports whether s is an IANA_SVC_NAME, the format of port
+// names
+func isIANASvcName(s string) bool {
+	return len(s) <= 15 &&
+		regexIANASvcName.MatchString(s) &&
+		strings.ContainsAny(s, "abcdefghijklmnopqrstuvwxyz") &&
+		!strings.Contains(s, "--")
+}
+
+// isLabelValue reports whether s is a valid label value
+func isLabelValue(s string) bool {
+	return len(s) <= 63 && regexLabelValue.MatchString(s)
+}
+
+// hasControlCharacters reports whether s contains a control character or a
+// Unicode line or paragraph separator, which YAML parsers may treat as line
+// breaks
+func hasControlCharacters(s string) bool {
+	for _, r := range s {
+		if unicode.IsControl(r) || r == '\u2028' || r == '\u2029' {
+			return true
+		}
+	}
+	return false
+}
+
+func validateStringMap(values map[string]string) map[string]interface{} {
+	errors := map[string]interface{}{}
+	for key, value := range values {
+		if hasControlCharacters(key) || hasControlCharacters(value) {
+			errors[key] = newValidationError(errMsgControlChars, key+": "+value)
+		}
+	}
+	return errors
+}
+
 func isIP(host string) bool {
 	return net.ParseIP(host) != nil
 }
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func isValidDuration(duration string) bool {
+	_, err := time.ParseDuration(duration)
+	return err == nil
+}
@@ -0,0 +1,194 @@
+package application_test
+
+import (
+	"strings"
+	"testing"
+
+	"deploy-wizard/gen/models"
+	"deploy-wizard/pkg/application"
+)
+
+// validationError returns the error of the field at a dotted path of the
+// nested validation errors, or "" if it has none
+func validationError(errors map[string]interface{}, field string) string {
+	keys := strings.Split(field, ".")
+	for _, key := range keys[:len(keys)-1] {
+		nested, ok := errors[key].(map[string]interface{})
+		if !ok {
+			return ""
+		}
+		errors = nested
+	}
+	if err, ok := errors[keys[len(keys)-1]].(error); ok {
+		return err.Error()
+	}
+	message, _ := errors[keys[len(keys)-1]].(string)
+	return message
+}
+
+func TestValidateReferences(t *testing.T) {
+	tests := []struct {
+		name     string
+		mutate   func(app *models.Application)
+		field    string
+		expected string
+	}{
+		{
+			"unknown ConfigMap",
+			func(app *models.Application) {
+				container := app.Spec.Components[1].Containers[0]
+				container.Volumes = []*models.VolumeMount{{MountPath: "/config", Name: "missing", Type: models.VolumeMountTypeConfigMap}}
+			},
+			"spec.components.1.containers.0.volumes.0.name",
+			`"missing" must be the name of a ConfigMap`,
+		},
+		{
+			"unknown PersistentVolume",
+			func(app *models.Application) {
+				container := app.Spec.Components[0].Containers[0]
+				container.Volumes = append(container.Volumes[:5:5], &models.VolumeMount{MountPath: "/data", Name: "missing", Type: models.VolumeMountTypePersistentVolume})
+			},
+			"spec.components.0.containers.0.volumes.5.name",
+			`"missing" must be the name of a PersistentVolume`,
+		},
+		{
+			"unknown container port",
+			func(app *models.Application) {
+				app.Spec.Components[2].Containers[0].PortNames = []string{"http", "metrics"}
+			},
+			"spec.components.2.containers.0.portNames.1",
+			`"metrics" must be the name of a port of service "worker"`,
+		},
+		{
+			"unknown probe port",
+			func(app *models.Application) {
+				app.Spec.Components[2].Containers[0].LivenessProbe = &models.Probe{PortName: "metrics"}
+			},
+			"spec.components.2.containers.0.livenessProbe.portName",
+			`"metrics" must be the name of a port of container "worker"`,
+		},
+		{
+			"relative probe path",
+			func(app *models.Application) {
+				app.Spec.Components[2].Containers[0].ReadinessProbe = &models.Probe{PortName: "http", Path: "healthz"}
+			},
+			"spec.components.2.containers.0.readinessProbe.path",
+			`"healthz" must be an absolute path`,
+		},
+		{
+			"unknown ingress port",
+			func(app *models.Application) { app.Spec.Components[0].Ingresses[0].Paths[0].PortName = "https" },
+			"spec.components.0.ingresses.0.paths.0.portName",
+			`"https" must be the name of a port of service "web"`,
+		},
+		{
+			"unused ConfigMap",
+			func(app *models.Application) {
+				app.Spec.ConfigMaps = append(app.Spec.ConfigMaps, &models.ConfigMap{Name: "unused", Data: "key: value"})
+			},
+			"spec.configMaps.3.name",
+			`"unused" is not mounted by any container`,
+		},
+		{
+			"unused PersistentVolume",
+			func(app *models.Application) {
+				for _, component := range app.Spec.Components {
+					component.Containers[0].Volumes = component.Containers[0].Volumes[:4]
+				}
+			},
+			"spec.persistentVolumes.1.name",
+			`"cache" is not mounted by any container`,
+		},
+		{
+			"duplicate service",
+			func(app *models.Application) { app.Spec.Components[2].Service.Name = "web" },
+			"spec.components.2.service.name",
+			`"web" is already the name of another component service`,
+		},
+		{
+			"duplicate service port",
+			func(app *models.Application) {
+				service := app.Spec.Components[1].Service
+				service.Ports = append(service.Ports, &models.ServicePort{Name: "http", Port: 8443})
+			},
+			"spec.components.1.service.ports.1.name",
+			`"http" is already the name of another port of the service`,
+		},
+		{
+			"duplicate container",
+			func(app *models.Application) {
+				component := app.Spec.Components[0]
+				component.Containers = append(component.Containers, &models.Container{
+					Name: "web", Image: "nginx", ImageTag: "1.19", ImagePullPolicy: "IfNotPresent", PortNames: []string{"http"},
+				})
+			},
+			"spec.components.0.containers.1.name",
+			`"web" is already the name of another container of the component`,
+		},
+		{
+			"duplicate ConfigMap",
+			func(app *models.Application) {
+				app.Spec.ConfigMaps = append(app.Spec.ConfigMaps, &models.ConfigMap{Name: "mid", Data: "key: other"})
+			},
+			"spec.configMaps.3.name",
+			`"mid" is already the name of another ConfigMap`,
+		},
+		{
+			"PersistentVolume named like a ConfigMap",
+			func(app *models.Application) {
+				app.Spec.PersistentVolumes = append(app.Spec.PersistentVolumes, &models.PersistentVolume{
+					Name: "alpha", Capacity: 1, AccessMode: models.PersistentVolumeAccessModeReadWriteOnce, StorageClassName: "SSD",
+				})
+			},
+			"spec.persistentVolumes.2.name",
+			`"alpha" is already the name of a ConfigMap`,
+		},
+		{
+			"duplicate mount path",
+			func(app *models.Application) {
+				container := app.Spec.Components[1].Containers[0]
+				container.Volumes = []*models.VolumeMount{
+					{MountPath: "/config", Name: "zeta", Type: models.VolumeMountTypeConfigMap},
+					{MountPath: "/config", Name: "alpha", Type: models.VolumeMountTypeConfigMap},
+				}
+			},
+			"spec.components.1.containers.0.volumes.1.mountPath",
+			`"/config" is already the name of another volume mount of the container`,
+		},
+	}
+
+	if errs := application.ValidateApplication(newDeterminismApplication(models.DestinationFormatKustomize)); len(errs) > 0 {
+		t.Fatalf("expected no errors, got %v", errs)
+	}
+
+	for _, test := range tests {
+		app := newDeterminismApplication(models.DestinationFormatKustomize)
+		test.mutate(app)
+
+		errs := application.ValidateApplication(app)
+		if message := validationError(errs, test.field); !strings.HasPrefix(message, test.expected) {
+			t.Errorf("%s: expected %s to be %q, got %v", test.name, test.field, test.expected, errs)
+		}
+	}
+}
+
+func TestValidateReferencesGeneratedConfigMap(t *testing.T) {
+	app := newDeterminismApplication(models.DestinationFormatKustomize)
+	app.Spec.Kustomize.ConfigMapGenerator = []*models.ConfigMapGenerator{{Name: "settings", Literals: []string{"KEY=value"}}}
+	container := app.Spec.Components[0].Containers[0]
+	container.Volumes = append(container.Volumes[:5:5], &models.VolumeMount{MountPath: "/settings", Name: "settings", Type: models.VolumeMountTypeConfigMap})
+
+	if errs := application.ValidateApplication(app); len(errs) > 0 {
+		t.Errorf("expected a generated ConfigMap to be mountable, got %v", errs)
+	}
+}
+
+func TestValidateReferencesKeepsFieldErrors(t *testing.T) {
+	app := newDeterminismApplication(models.DestinationFormatKustomize)
+	app.Spec.Components[0].Ingresses[0].Paths[0].PortName = "HTTP"
+
+	errs := application.ValidateApplication(app)
+	if message := validationError(errs, "spec.components.0.ingresses.0.paths.0.portName"); !strings.Contains(message, "IANA_SVC_NAME") {
+		t.Errorf("expected the format error of the port name, got %v", errs)
+	}
+}
@@ -0,0 +1,89 @@
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+const (
+	// TarGz is a gzip compressed tar archive
+	TarGz = "tar.gz"
+	// Zip is a zip archive
+	Zip = "zip"
+
+	fileMode = 0644
+)
+
+// Write writes the files, keyed by their path, to w as an archive of the
+// format. Files are written in path order.
+func Write(w io.Writer, format string, files map[string]string) error {
+	switch format {
+	case TarGz:
+		return writeTarGz(w, files)
+	case Zip:
+		return writeZip(w, files)
+	default:
+		return fmt.Errorf("unsupported archive format %q", format)
+	}
+}
+
+func writeTarGz(w io.Writer, files map[string]string) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	modTime := time.Now()
+	for _, name := range sortedNames(files) {
+		content := files[name]
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    name,
+			Mode:    fileMode,
+			Size:    int64(len(content)),
+			ModTime: modTime,
+		}); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(tw, content); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+func writeZip(w io.Writer, files map[string]string) error {
+	zw := zip.NewWriter(w)
+
+	modTime := time.Now()
+	for _, name := range sortedNames(files) {
+		header := &zip.FileHeader{Name: name, Method: zip.Deflate}
+		header.SetModTime(modTime)
+		header.SetMode(fileMode)
+
+		f, err := zw.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(f, files[name]); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+func sortedNames(files map[string]string) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
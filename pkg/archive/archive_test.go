@@ -0,0 +1,90 @@
+package archive_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"deploy-wizard/pkg/archive"
+)
+
+var files = map[string]string{
+	"deploy/base/kustomization.yaml": "resources:\n- service-account.yaml\n",
+	"apps/app1.yaml":                 "kind: Application\n",
+}
+
+func TestWriteTarGz(t *testing.T) {
+	var buf bytes.Buffer
+	if err := archive.Write(&buf, archive.TarGz, files); err != nil {
+		t.Fatal(err)
+	}
+
+	gr, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gr)
+
+	var names []string
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := ioutil.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(content) != files[header.Name] {
+			t.Errorf("%s: expected %q, got %q", header.Name, files[header.Name], content)
+		}
+		names = append(names, header.Name)
+	}
+
+	if len(names) != 2 || names[0] != "apps/app1.yaml" {
+		t.Errorf("expected the files in path order, got %v", names)
+	}
+}
+
+func TestWriteZip(t *testing.T) {
+	var buf bytes.Buffer
+	if err := archive.Write(&buf, archive.Zip, files); err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(zr.File) != 2 {
+		t.Fatalf("expected 2 files, got %d", len(zr.File))
+	}
+
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := ioutil.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(content) != files[f.Name] {
+			t.Errorf("%s: expected %q, got %q", f.Name, files[f.Name], content)
+		}
+	}
+}
+
+func TestWriteUnsupported(t *testing.T) {
+	if err := archive.Write(ioutil.Discard, "rar", files); err == nil {
+		t.Error("expected an error for an unsupported format")
+	}
+}
@@ -0,0 +1,58 @@
+package config
+
+import (
+	"io/ioutil"
+
+	"deploy-wizard/pkg/application"
+
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Config is the server-side configuration of the deploy wizard
+type Config struct {
+	// Clusters maps regions and environments to ArgoCD destination clusters
+	Clusters application.Clusters `yaml:"clusters"`
+
+	// Environments holds the configuration of each env
+	Environments application.Environments `yaml:"environments"`
+
+	// Policies are the profiles of rules enforced on the applications of an env or team
+	Policies application.Policies `yaml:"policies"`
+}
+
+// Load reads the configuration from a YAML file. An empty filename returns
+// the default configuration.
+func Load(filename string) (*Config, error) {
+	cfg := &Config{}
+	if filename == "" {
+		return cfg, nil
+	}
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read config file %q", filename)
+	}
+
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse config file %q", filename)
+	}
+
+	if err := cfg.Clusters.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid config file %q", filename)
+	}
+
+	if err := cfg.Environments.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid config file %q", filename)
+	}
+
+	if err := cfg.Clusters.ValidateDeployTargets(cfg.Environments); err != nil {
+		return nil, errors.Wrapf(err, "invalid config file %q", filename)
+	}
+
+	if err := cfg.Policies.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid config file %q", filename)
+	}
+
+	return cfg, nil
+}
@@ -5,14 +5,19 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
+	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 	"time"
 
 	"gopkg.in/src-d/go-billy.v4"
 	"gopkg.in/src-d/go-billy.v4/memfs"
 	gitclient "gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/plumbing"
 	"gopkg.in/src-d/go-git.v4/plumbing/object"
 	gittransport "gopkg.in/src-d/go-git.v4/plumbing/transport/client"
 	githttp "gopkg.in/src-d/go-git.v4/plumbing/transport/http"
@@ -22,6 +27,11 @@ import (
 var (
 	// ErrRepoIsNotCloned is returned when an operation depends on a cloned repo
 	ErrRepoIsNotCloned = errors.New("repo is not cloned")
+	// ErrRefIsNotABranch is returned when committing to a ref that is a tag or
+	// a commit, which can not be pushed to
+	ErrRefIsNotABranch = errors.New("ref is not a branch")
+
+	regexCommitSHA = regexp.MustCompile(`^[0-9a-f]{40}$`)
 )
 
 const (
@@ -35,9 +45,11 @@ type Repo struct {
 	creds   *RepoCreds
 	prefix  string
 	ref     string
+	branch  bool
 	fs      billy.Filesystem
 	r       *gitclient.Repository
 	files   map[string]string
+	removed map[string]struct{}
 }
 
 // RepoCreds contains https basic authentication for a git repo
@@ -63,26 +75,147 @@ func NewRepo(repoURL, prefix, ref string, creds *RepoCreds, insecureSkipVerify b
 		ref:     ref,
 		fs:      memfs.New(),
 		files:   map[string]string{},
+		removed: map[string]struct{}{},
 	}
 }
 
-// Clone clones the repository
+// Clone clones the repository and checks out its ref. The ref is a branch,
+// a tag, a full reference name or a commit SHA. HEAD or an empty ref checks
+// out the default branch. A ref that is not a branch can be read but not
+// committed to.
 func (r *Repo) Clone() error {
-	gitrepo, err := gitclient.Clone(memory.NewStorage(), r.fs, &gitclient.CloneOptions{
-		URL: r.repoURL,
-		Auth: &githttp.BasicAuth{
-			Username: r.creds.Username,
-			Password: r.creds.Password,
-		},
-	})
+	var referenceNames []plumbing.ReferenceName
+	switch {
+	case r.ref == "" || r.ref == "HEAD" || regexCommitSHA.MatchString(r.ref):
+		referenceNames = []plumbing.ReferenceName{""}
+	case strings.HasPrefix(r.ref, "refs/"):
+		referenceNames = []plumbing.ReferenceName{plumbing.ReferenceName(r.ref)}
+	default:
+		referenceNames = []plumbing.ReferenceName{
+			plumbing.NewBranchReferenceName(r.ref),
+			plumbing.NewTagReferenceName(r.ref),
+		}
+	}
+
+	var err error
+	for _, referenceName := range referenceNames {
+		r.fs = memfs.New()
+		r.r, err = gitclient.Clone(memory.NewStorage(), r.fs, &gitclient.CloneOptions{
+			URL: r.repoURL,
+			Auth: &githttp.BasicAuth{
+				Username: r.creds.Username,
+				Password: r.creds.Password,
+			},
+			ReferenceName: referenceName,
+			SingleBranch:  referenceName != "",
+		})
+		if !isRefNotFound(err) {
+			break
+		}
+	}
 	if err != nil {
+		r.r = nil
 		return err
 	}
-	r.r = gitrepo
+
+	if regexCommitSHA.MatchString(r.ref) {
+		wt, err := r.r.Worktree()
+		if err != nil {
+			return err
+		}
+		if err := wt.Checkout(&gitclient.CheckoutOptions{Hash: plumbing.NewHash(r.ref)}); err != nil {
+			return err
+		}
+	}
+
+	head, err := r.r.Head()
+	if err != nil {
+		return err
+	}
+	r.branch = head.Name().IsBranch()
 
 	return nil
 }
 
+// IsBranch returns whether the cloned ref is a branch, as opposed to a tag or
+// a commit
+func (r *Repo) IsBranch() bool {
+	return r.r != nil && r.branch
+}
+
+// isRefNotFound returns whether cloning failed because the remote has no
+// such ref. go-git reports a missing ref of a single branch clone with an
+// error of its own message.
+func isRefNotFound(err error) bool {
+	return err == plumbing.ErrReferenceNotFound ||
+		(err != nil && strings.HasPrefix(err.Error(), "couldn't find remote ref"))
+}
+
+// ReadFile reads a file relative to the root of the cloned repo
+func (r *Repo) ReadFile(fileName string) (string, error) {
+	if r.r == nil {
+		return "", ErrRepoIsNotCloned
+	}
+
+	f, err := r.fs.Open(fileName)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// ListFiles returns the files under a directory of the cloned repo, relative
+// to the root of the repo. A directory that does not exist has no files.
+func (r *Repo) ListFiles(dir string) ([]string, error) {
+	if r.r == nil {
+		return nil, ErrRepoIsNotCloned
+	}
+
+	var files []string
+	var walk func(dir string) error
+	walk = func(dir string) error {
+		infos, err := r.fs.ReadDir(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		for _, info := range infos {
+			name := filepath.Join(dir, info.Name())
+			if info.IsDir() {
+				if info.Name() == gitclient.GitDirName {
+					continue
+				}
+				if err := walk(name); err != nil {
+					return err
+				}
+				continue
+			}
+			files = append(files, strings.TrimPrefix(name, "/"))
+		}
+		return nil
+	}
+
+	if err := walk(filepath.Join("/", dir)); err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// RemoveFile removes a file, relative to the root of the repo, with the next commit
+func (r *Repo) RemoveFile(fileName string) {
+	r.removed[fileName] = struct{}{}
+}
+
 // AddFile adds a file to the Repo
 func (r *Repo) AddFile(fileName string, content string) {
 	r.files[fileName] = content
@@ -125,11 +258,14 @@ func (r *Repo) AddDeploySpec(fileName string, content string) error {
 	return nil
 }
 
-// Commit commits the current state of the repo
+// Commit commits the current state of the repo to the cloned branch
 func (r *Repo) Commit(msg string) error {
 	if r.r == nil {
 		return ErrRepoIsNotCloned
 	}
+	if !r.branch {
+		return ErrRefIsNotABranch
+	}
 	wt, err := r.r.Worktree()
 	if err != nil {
 		return err
@@ -164,7 +300,13 @@ func (r *Repo) Commit(msg string) error {
 		}
 	}
 
-	// commit the added files
+	for name := range r.removed {
+		if _, err := wt.Remove(name); err != nil {
+			return err
+		}
+	}
+
+	// commit the added and removed files
 	_, err = wt.Commit(
 		msg,
 		&gitclient.CommitOptions{
@@ -209,6 +351,4 @@ func (r *Repo) Log() error {
 		fmt.Println(c)
 		return nil
 	})
-
-	return nil
 }
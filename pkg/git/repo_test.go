@@ -1,7 +1,11 @@
 package git_test
 
 import (
+	"io/ioutil"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"deploy-wizard/pkg/git"
@@ -58,3 +62,141 @@ func TestClone(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestCloneRef(t *testing.T) {
+	dir, err := ioutil.TempDir("", "repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"checkout", "-q", "-b", "master"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "--allow-empty", "-m", "initial"},
+		{"checkout", "-q", "-b", "release-1.0"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "deploy", "base"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "deploy", "base", "kustomization.yaml"), []byte("resources: []\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for _, args := range [][]string{
+		{"add", "."},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "-m", "release"},
+		{"checkout", "-q", "master"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+
+	repo := git.NewRepo(dir, "/deploy", "release-1.0", &git.RepoCreds{}, false)
+	if err := repo.Clone(); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := repo.ListFiles("deploy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0] != "deploy/base/kustomization.yaml" {
+		t.Errorf("expected the files of the release-1.0 branch, got %v", files)
+	}
+
+	repo = git.NewRepo(dir, "/deploy", "HEAD", &git.RepoCreds{}, false)
+	if err := repo.Clone(); err != nil {
+		t.Fatal(err)
+	}
+	if files, err := repo.ListFiles("deploy"); err != nil || len(files) != 0 {
+		t.Errorf("expected no files on the default branch, got %v (%v)", files, err)
+	}
+}
+
+// gitCommand runs a git command in a directory and returns its output
+func gitCommand(t *testing.T, dir string, args ...string) string {
+	cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func TestCloneTagAndCommit(t *testing.T) {
+	dir, err := ioutil.TempDir("", "repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	work := filepath.Join(dir, "work")
+	if err := os.MkdirAll(filepath.Join(work, "deploy"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	gitCommand(t, work, "init", "-q")
+	gitCommand(t, work, "checkout", "-q", "-b", "master")
+	if err := ioutil.WriteFile(filepath.Join(work, "deploy", "v1.yaml"), []byte("version: 1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	gitCommand(t, work, "add", ".")
+	gitCommand(t, work, "commit", "-q", "-m", "v1")
+	gitCommand(t, work, "tag", "v1")
+	sha := gitCommand(t, work, "rev-parse", "HEAD")
+	if err := ioutil.WriteFile(filepath.Join(work, "deploy", "v2.yaml"), []byte("version: 2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	gitCommand(t, work, "add", ".")
+	gitCommand(t, work, "commit", "-q", "-m", "v2")
+	gitCommand(t, dir, "clone", "-q", "--bare", work, "remote.git")
+	remote := filepath.Join(dir, "remote.git")
+
+	for _, ref := range []string{"v1", "refs/tags/v1", sha} {
+		repo := git.NewRepo(remote, "/deploy", ref, &git.RepoCreds{}, false)
+		if err := repo.Clone(); err != nil {
+			t.Fatalf("%s: %v", ref, err)
+		}
+		files, err := repo.ListFiles("deploy")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) != 1 || files[0] != "deploy/v1.yaml" {
+			t.Errorf("%s: expected the files of v1, got %v", ref, files)
+		}
+		if repo.IsBranch() {
+			t.Errorf("%s: expected a ref that is not a branch", ref)
+		}
+		repo.AddFile("v3.yaml", "version: 3\n")
+		if err := repo.Commit("v3"); err != git.ErrRefIsNotABranch {
+			t.Errorf("%s: expected committing to fail with %v, got %v", ref, git.ErrRefIsNotABranch, err)
+		}
+	}
+
+	repo := git.NewRepo(remote, "/deploy", "master", &git.RepoCreds{}, false)
+	if err := repo.Clone(); err != nil {
+		t.Fatal(err)
+	}
+	if !repo.IsBranch() {
+		t.Fatal("expected master to be a branch")
+	}
+	repo.AddFile("v3.yaml", "version: 3\n")
+	if err := repo.Commit("v3"); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Push(); err != nil {
+		t.Fatal(err)
+	}
+	if subject := gitCommand(t, remote, "log", "-1", "--format=%s", "master"); subject != "v3" {
+		t.Errorf("expected the commit to be pushed to master, got %q", subject)
+	}
+}
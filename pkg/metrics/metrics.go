@@ -25,4 +25,11 @@ var (
 		Name:      "apps_rendered_count",
 		Help:      "apps rendered count.",
 	}, []string{"app"})
+
+	// TemplateReloadCount tracks the number of template reloads by result
+	TemplateReloadCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "template_reload_count",
+		Help:      "template reload count.",
+	}, []string{"result"})
 )
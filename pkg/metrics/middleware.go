@@ -37,5 +37,6 @@ func SetupHandler(handler http.Handler, app string) http.Handler {
 	prometheus.MustRegister(histogram)
 	prometheus.MustRegister(counter)
 	prometheus.MustRegister(AppsRenderedCount)
+	prometheus.MustRegister(TemplateReloadCount)
 	return h
 }
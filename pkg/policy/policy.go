@@ -0,0 +1,260 @@
+// Package policy evaluates Rego policies against Kubernetes manifests with an
+// embedded engine, without a running OPA server
+package policy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/open-policy-agent/opa/ast"
+	"github.com/open-policy-agent/opa/rego"
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Rules are the rules of a policy package that are evaluated, each a set of
+// messages like the rules conftest and Gatekeeper policies define
+const (
+	RuleDeny = "deny"
+	RuleWarn = "warn"
+)
+
+// Engine evaluates the deny and warn rules of the packages of Rego modules
+type Engine struct {
+	packages []string
+	queries  map[string]rego.PreparedEvalQuery
+}
+
+// Result is a message of the deny or warn rule of a policy package about a
+// resource
+type Result struct {
+	// Policy is the path of the package, e.g. kubernetes.security
+	Policy string
+	// Resource is the kind and name of the resource, e.g. Deployment/web
+	Resource string
+	Message  string
+}
+
+// Results holds the messages of the deny and warn rules about a manifest
+type Results struct {
+	Deny []Result
+	Warn []Result
+}
+
+// Load compiles the Rego modules of a directory and its subdirectories.
+// Files ending in _test.rego hold the tests of the policies and are skipped.
+func Load(dir string) (*Engine, error) {
+	modules := map[string]string{}
+	err := filepath.Walk(dir, func(filename string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(filename) != ".rego" || strings.HasSuffix(filename, "_test.rego") {
+			return nil
+		}
+		content, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		modules[filename] = string(content)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading policies from %q", dir)
+	}
+
+	return Compile(modules)
+}
+
+// Compile compiles Rego modules by filename. The deny and warn rules of a
+// module must be sets of messages.
+func Compile(modules map[string]string) (*Engine, error) {
+	compiler, err := ast.CompileModules(modules)
+	if err != nil {
+		return nil, errors.Wrap(err, "compiling policies")
+	}
+	if err := checkRules(compiler.Modules); err != nil {
+		return nil, err
+	}
+
+	e := &Engine{queries: map[string]rego.PreparedEvalQuery{}}
+	seen := map[string]struct{}{}
+	for _, module := range compiler.Modules {
+		pkg := module.Package.Path.String()
+		if _, ok := seen[pkg]; ok {
+			continue
+		}
+		seen[pkg] = struct{}{}
+		e.packages = append(e.packages, pkg)
+	}
+	sort.Strings(e.packages)
+
+	for _, pkg := range e.packages {
+		for _, rule := range []string{RuleDeny, RuleWarn} {
+			query, err := rego.New(rego.Query(pkg+"."+rule), rego.Compiler(compiler)).PrepareForEval(context.Background())
+			if err != nil {
+				return nil, errors.Wrapf(err, "preparing %s.%s", pkg, rule)
+			}
+			e.queries[pkg+"."+rule] = query
+		}
+	}
+	return e, nil
+}
+
+// checkRules returns an error for a deny or warn rule that is not a set, e.g.
+// deny { ... } instead of deny[msg] { ... }
+func checkRules(modules map[string]*ast.Module) error {
+	var filenames []string
+	for filename := range modules {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	for _, filename := range filenames {
+		module := modules[filename]
+		for _, rule := range module.Rules {
+			name := rule.Head.Name.String()
+			if name != RuleDeny && name != RuleWarn || rule.Head.DocKind() == ast.PartialSetDoc {
+				continue
+			}
+			location := filename
+			if rule.Location != nil {
+				location = rule.Location.String()
+			}
+			return fmt.Errorf("%s: %s.%s must be a set of messages, e.g. %s[msg] { ... }", location, module.Package.Path, name, name)
+		}
+	}
+	return nil
+}
+
+// Evaluate evaluates the deny and warn rules of every package with each
+// resource of a YAML manifest as input. The messages of a rule are ordered
+// by policy, resource and message.
+func (e *Engine) Evaluate(ctx context.Context, manifest string) (*Results, error) {
+	results := &Results{}
+	dec := yaml.NewDecoder(bytes.NewBufferString(manifest))
+	for {
+		var doc interface{}
+		err := dec.Decode(&doc)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "decoding manifest")
+		}
+		input, ok := jsonValue(doc).(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		resource := resourceName(input)
+		for _, pkg := range e.packages {
+			policy := strings.TrimPrefix(pkg, "data.")
+			deny, err := e.evaluate(ctx, pkg+"."+RuleDeny, input)
+			if err != nil {
+				return nil, err
+			}
+			for _, message := range deny {
+				results.Deny = append(results.Deny, Result{Policy: policy, Resource: resource, Message: message})
+			}
+			warn, err := e.evaluate(ctx, pkg+"."+RuleWarn, input)
+			if err != nil {
+				return nil, err
+			}
+			for _, message := range warn {
+				results.Warn = append(results.Warn, Result{Policy: policy, Resource: resource, Message: message})
+			}
+		}
+	}
+
+	sortResults(results.Deny)
+	sortResults(results.Warn)
+	return results, nil
+}
+
+// evaluate returns the messages of a rule. A message is a string or an object
+// with a msg field; a rule a package does not define has none.
+func (e *Engine) evaluate(ctx context.Context, query string, input interface{}) ([]string, error) {
+	rs, err := e.queries[query].Eval(ctx, rego.EvalInput(input))
+	if err != nil {
+		return nil, errors.Wrapf(err, "evaluating %s", query)
+	}
+
+	var messages []string
+	for _, result := range rs {
+		for _, expression := range result.Expressions {
+			values, ok := expression.Value.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s must be a set of messages", query)
+			}
+			for _, value := range values {
+				switch value := value.(type) {
+				case string:
+					messages = append(messages, value)
+				case map[string]interface{}:
+					if message, ok := value["msg"].(string); ok {
+						messages = append(messages, message)
+						continue
+					}
+					return nil, fmt.Errorf("%s must be a set of messages, got an object without msg", query)
+				default:
+					return nil, fmt.Errorf("%s must be a set of messages, got %v", query, value)
+				}
+			}
+		}
+	}
+	return messages, nil
+}
+
+// resourceName returns the kind and name of a resource, e.g. Deployment/web
+func resourceName(resource map[string]interface{}) string {
+	kind, _ := resource["kind"].(string)
+	metadata, _ := resource["metadata"].(map[string]interface{})
+	name, _ := metadata["name"].(string)
+	switch {
+	case kind == "":
+		return name
+	case name == "":
+		return kind
+	}
+	return kind + "/" + name
+}
+
+func sortResults(results []Result) {
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Policy != results[j].Policy {
+			return results[i].Policy < results[j].Policy
+		}
+		if results[i].Resource != results[j].Resource {
+			return results[i].Resource < results[j].Resource
+		}
+		return results[i].Message < results[j].Message
+	})
+}
+
+// jsonValue converts a decoded YAML value to the JSON-compatible values Rego
+// evaluates: maps keyed by strings
+func jsonValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			m[fmt.Sprint(k)] = jsonValue(v)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(value))
+		for i, v := range value {
+			s[i] = jsonValue(v)
+		}
+		return s
+	}
+	return value
+}
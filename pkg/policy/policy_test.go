@@ -0,0 +1,178 @@
+package policy_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"deploy-wizard/pkg/policy"
+)
+
+const security = `package kubernetes.security
+
+deny[msg] {
+	input.kind == "Deployment"
+	container := input.spec.template.spec.containers[_]
+	not container.securityContext.runAsNonRoot
+	msg := sprintf("container %s must run as non-root", [container.name])
+}
+
+warn[{"msg": msg}] {
+	input.kind == "Service"
+	input.spec.type == "LoadBalancer"
+	msg := "LoadBalancer services are billed"
+}
+`
+
+const labels = `package kubernetes.labels
+
+deny[msg] {
+	not input.metadata.labels.team
+	msg := "team label is required"
+}
+`
+
+const manifest = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  labels:
+    team: tenant1
+spec:
+  template:
+    spec:
+      containers:
+      - name: web
+        image: nginx:1.19
+      - name: sidecar
+        image: envoy:1.16
+        securityContext:
+          runAsNonRoot: true
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+spec:
+  type: LoadBalancer
+`
+
+// writePolicies writes modules by filename to a temporary directory
+func writePolicies(t *testing.T, modules map[string]string) string {
+	dir, err := ioutil.TempDir("", "policy")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for filename, content := range modules {
+		filename = filepath.Join(dir, filename)
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filename, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func load(t *testing.T, dir string) *policy.Engine {
+	engine, err := policy.Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return engine
+}
+
+func TestEvaluate(t *testing.T) {
+	dir := writePolicies(t, map[string]string{
+		"security.rego":           security,
+		"labels/labels.rego":      labels,
+		"labels/labels_test.rego": "package kubernetes.labels\n\ntest_broken { false",
+		"README.md":               "not a policy",
+	})
+	defer os.RemoveAll(dir)
+	engine := load(t, dir)
+
+	results, err := engine.Evaluate(context.Background(), manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []policy.Result{
+		{Policy: "kubernetes.labels", Resource: "Service/web", Message: "team label is required"},
+		{Policy: "kubernetes.security", Resource: "Deployment/web", Message: "container web must run as non-root"},
+	}
+	if len(results.Deny) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, results.Deny)
+	}
+	for i, result := range results.Deny {
+		if result != expected[i] {
+			t.Errorf("expected %v, got %v", expected[i], result)
+		}
+	}
+
+	if len(results.Warn) != 1 || results.Warn[0] != (policy.Result{Policy: "kubernetes.security", Resource: "Service/web", Message: "LoadBalancer services are billed"}) {
+		t.Errorf("expected the warning about the LoadBalancer, got %v", results.Warn)
+	}
+}
+
+func TestEvaluateSkipsDocumentsThatAreNotResources(t *testing.T) {
+	dir := writePolicies(t, map[string]string{"labels.rego": labels})
+	defer os.RemoveAll(dir)
+	engine := load(t, dir)
+
+	results, err := engine.Evaluate(context.Background(), "---\n# empty\n---\n- a list\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results.Deny) > 0 || len(results.Warn) > 0 {
+		t.Errorf("expected no results, got %+v", results)
+	}
+}
+
+func TestLoadInvalidPolicy(t *testing.T) {
+	dir := writePolicies(t, map[string]string{"broken.rego": "package broken\n\ndeny[msg] {"})
+	defer os.RemoveAll(dir)
+
+	if _, err := policy.Load(dir); err == nil || !strings.Contains(err.Error(), "compiling policies") {
+		t.Errorf("expected a compile error, got %v", err)
+	}
+	if _, err := policy.Load(filepath.Join(dir, "missing")); err == nil {
+		t.Error("expected an error for a missing directory")
+	}
+}
+
+func TestEvaluateInvalidMessages(t *testing.T) {
+	engine, err := policy.Compile(map[string]string{
+		"numbers.rego": "package numbers\n\ndeny[1] { true }\n",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := engine.Evaluate(context.Background(), manifest); err == nil {
+		t.Error("expected an error for a message that is not a string")
+	}
+}
+
+func TestCompileRulesThatAreNotSets(t *testing.T) {
+	for name, module := range map[string]string{
+		"boolean": "package rules\n\ndeny { input.kind == \"Deployment\" }\n",
+		"message": "package rules\n\nwarn = \"always\" { true }\n",
+		"object":  "package rules\n\ndeny[\"key\"] = \"value\" { true }\n",
+	} {
+		_, err := policy.Compile(map[string]string{"rules.rego": module})
+		if err == nil || !strings.Contains(err.Error(), "must be a set of messages") || !strings.HasPrefix(err.Error(), "rules.rego:3") {
+			t.Errorf("%s: expected an error about the rule, got %v", name, err)
+		}
+	}
+
+	if _, err := policy.Compile(map[string]string{"rules.rego": "package rules\n\nallow { true }\n"}); err != nil {
+		t.Errorf("expected rules other than deny and warn to be left alone, got %v", err)
+	}
+}
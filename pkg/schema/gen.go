@@ -0,0 +1,195 @@
+//go:build ignore
+// +build ignore
+
+// gen writes the OpenAPI schemas of the bundled Kubernetes versions to
+// specs.go. The specs are read from the k8s.io/kubernetes module and trimmed
+// to the kinds the renderer produces, without descriptions. Objects are made
+// strict so that misspelled fields are reported.
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// versions maps the bundled Kubernetes versions to the release they are read
+// from
+var versions = map[string]string{
+	"1.19": "v1.19.16",
+	"1.22": "v1.22.17",
+	"1.25": "v1.25.16",
+}
+
+// kinds are the kinds whose schemas are bundled, in every group version
+// that serves them
+var kinds = []string{
+	"ConfigMap", "CronJob", "DaemonSet", "Deployment", "HorizontalPodAutoscaler",
+	"Ingress", "Job", "Namespace", "NetworkPolicy", "PersistentVolumeClaim", "Pod",
+	"PodDisruptionBudget", "ReplicaSet", "Secret", "Service", "ServiceAccount", "StatefulSet",
+}
+
+// untyped are the definitions that accept more than one JSON type, which the
+// specs declare as strings
+var untyped = map[string][]string{
+	"io.k8s.apimachinery.pkg.api.resource.Quantity":   {"string", "number"},
+	"io.k8s.apimachinery.pkg.util.intstr.IntOrString": {"string", "integer"},
+}
+
+func main() {
+	var names []string
+	for version := range versions {
+		names = append(names, version)
+	}
+	sort.Strings(names)
+
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "// Code generated by gen.go from the OpenAPI specs of Kubernetes. DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package schema")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// specs are the gzipped and base64 encoded OpenAPI specs by Kubernetes version")
+	fmt.Fprintln(&b, "var specs = map[string]string{")
+	for _, version := range names {
+		spec, err := trimmedSpec(versions[version])
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Fprintf(&b, "%q: %q,\n", version, spec)
+	}
+	fmt.Fprintln(&b, "}")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("specs.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func trimmedSpec(release string) (string, error) {
+	out, err := exec.Command("go", "mod", "download", "-json", "k8s.io/kubernetes@"+release).Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to download kubernetes %s: %v", release, err)
+	}
+	var module struct{ Dir string }
+	if err := json.Unmarshal(out, &module); err != nil {
+		return "", err
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(module.Dir, "api", "openapi-spec", "swagger.json"))
+	if err != nil {
+		return "", err
+	}
+
+	var spec struct {
+		Definitions map[string]map[string]interface{} `json:"definitions"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&spec); err != nil {
+		return "", err
+	}
+
+	definitions := map[string]interface{}{}
+	var add func(name string)
+	add = func(name string) {
+		if _, ok := definitions[name]; ok {
+			return
+		}
+		definition := spec.Definitions[name]
+		definitions[name] = definition
+		for _, ref := range trim(definition) {
+			add(strings.TrimPrefix(ref, "#/definitions/"))
+		}
+	}
+	for name, definition := range spec.Definitions {
+		if isBundled(definition) {
+			add(name)
+		}
+	}
+	for name, types := range untyped {
+		if _, ok := definitions[name]; !ok {
+			continue
+		}
+		var anyOf []interface{}
+		for _, t := range types {
+			anyOf = append(anyOf, map[string]string{"type": t})
+		}
+		definitions[name] = map[string]interface{}{"anyOf": anyOf}
+	}
+
+	trimmed, err := json.Marshal(map[string]interface{}{
+		"swagger":     "2.0",
+		"info":        map[string]string{"title": "Kubernetes", "version": release},
+		"paths":       map[string]interface{}{},
+		"definitions": definitions,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var gz bytes.Buffer
+	w, _ := gzip.NewWriterLevel(&gz, gzip.BestCompression)
+	if _, err := w.Write(trimmed); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(gz.Bytes()), nil
+}
+
+func isBundled(definition map[string]interface{}) bool {
+	gvks, _ := definition["x-kubernetes-group-version-kind"].([]interface{})
+	for _, gvk := range gvks {
+		kind, _ := gvk.(map[string]interface{})["kind"].(string)
+		for _, k := range kinds {
+			if kind == k {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// trim removes the descriptions and extensions other than the group version
+// kind from a schema, makes its objects strict and returns its references
+func trim(schema interface{}) []string {
+	var refs []string
+	switch s := schema.(type) {
+	case map[string]interface{}:
+		for key, value := range s {
+			switch {
+			case key == "$ref":
+				refs = append(refs, value.(string))
+			case key == "description", strings.HasPrefix(key, "x-") && key != "x-kubernetes-group-version-kind":
+				delete(s, key)
+			case key == "properties":
+				for _, property := range value.(map[string]interface{}) {
+					refs = append(refs, trim(property)...)
+				}
+			case key == "items", key == "additionalProperties":
+				refs = append(refs, trim(value)...)
+			}
+		}
+		if _, ok := s["properties"]; ok {
+			s["additionalProperties"] = false
+		}
+	case []interface{}:
+		for _, item := range s {
+			refs = append(refs, trim(item)...)
+		}
+	}
+	return refs
+}
@@ -0,0 +1,272 @@
+// Package schema validates Kubernetes manifests against the OpenAPI schemas
+// of the Kubernetes versions bundled with the server, without network access
+package schema
+
+//go:generate go run gen.go
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// DefaultVersion is the Kubernetes version manifests are validated against
+// unless another one is selected: the version the default templates target
+const DefaultVersion = "1.19"
+
+var (
+	// builtinGroups are the API groups of the bundled kinds. Resources of
+	// other groups, like custom resources, are not validated.
+	builtinGroups = []string{"", "apps", "autoscaling", "batch", "extensions", "networking.k8s.io", "policy"}
+
+	validators   = map[string]*Validator{}
+	validatorsMu sync.Mutex
+)
+
+// Versions returns the bundled Kubernetes versions in ascending order
+func Versions() []string {
+	var versions []string
+	for version := range specs {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// Validator validates manifests against the schemas of a Kubernetes version
+type Validator struct {
+	version string
+	doc     *spec.Swagger
+	kinds   map[string]string
+
+	mu         sync.Mutex
+	validators map[string]*validate.SchemaValidator
+}
+
+// gvk is the x-kubernetes-group-version-kind extension of a definition
+type gvk struct {
+	Group   string `json:"group"`
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+}
+
+func (g gvk) apiVersion() string {
+	if g.Group == "" {
+		return g.Version
+	}
+	return g.Group + "/" + g.Version
+}
+
+// NewValidator returns the validator of a bundled Kubernetes version
+func NewValidator(version string) (*Validator, error) {
+	validatorsMu.Lock()
+	defer validatorsMu.Unlock()
+
+	if v, ok := validators[version]; ok {
+		return v, nil
+	}
+
+	encoded, ok := specs[version]
+	if !ok {
+		return nil, errors.Errorf("no schemas for Kubernetes %s, expected one of %s", version, strings.Join(Versions(), ", "))
+	}
+
+	data, err := decodeSpec(encoded)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode the schemas of Kubernetes %s", version)
+	}
+
+	doc := &spec.Swagger{}
+	if err := json.Unmarshal(data, doc); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the schemas of Kubernetes %s", version)
+	}
+
+	v := &Validator{
+		version:    version,
+		doc:        doc,
+		kinds:      map[string]string{},
+		validators: map[string]*validate.SchemaValidator{},
+	}
+	for name, definition := range doc.Definitions {
+		var gvks []gvk
+		if ext, ok := definition.Extensions["x-kubernetes-group-version-kind"]; ok {
+			raw, err := json.Marshal(ext)
+			if err != nil {
+				return nil, err
+			}
+			if err := json.Unmarshal(raw, &gvks); err != nil {
+				return nil, err
+			}
+		}
+		for _, g := range gvks {
+			v.kinds[kindKey(g.apiVersion(), g.Kind)] = name
+		}
+	}
+
+	validators[version] = v
+	return v, nil
+}
+
+func decodeSpec(encoded string) ([]byte, error) {
+	gz, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	r, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = r.Close() }()
+	return ioutil.ReadAll(r)
+}
+
+func kindKey(apiVersion, kind string) string {
+	return apiVersion + ", Kind=" + kind
+}
+
+// Version returns the Kubernetes version of the validator
+func (v *Validator) Version() string {
+	return v.version
+}
+
+// Has reports whether the Kubernetes version serves a kind in an API version
+func (v *Validator) Has(apiVersion, kind string) bool {
+	_, ok := v.kinds[kindKey(apiVersion, kind)]
+	return ok
+}
+
+// Validate parses the resources of a manifest and returns what is wrong with
+// them. Resources of API groups other than the built-in ones are not checked.
+func (v *Validator) Validate(manifest string) []string {
+	var problems []string
+
+	decoder := yaml.NewDecoder(strings.NewReader(manifest))
+	for {
+		var resource interface{}
+		err := decoder.Decode(&resource)
+		if err == io.EOF {
+			return problems
+		}
+		if err != nil {
+			return append(problems, fmt.Sprintf("the file is not valid YAML: %s", err))
+		}
+		if resource == nil {
+			continue
+		}
+
+		obj, ok := toJSON(resource).(map[string]interface{})
+		if !ok {
+			problems = append(problems, "the resource is not an object")
+			continue
+		}
+		problems = append(problems, v.validateResource(obj)...)
+	}
+}
+
+func (v *Validator) validateResource(obj map[string]interface{}) []string {
+	apiVersion, _ := obj["apiVersion"].(string)
+	kind, _ := obj["kind"].(string)
+	if apiVersion == "" || kind == "" {
+		return []string{"the resource has no apiVersion or kind"}
+	}
+
+	name, ok := v.kinds[kindKey(apiVersion, kind)]
+	if !ok {
+		group := ""
+		if i := strings.LastIndex(apiVersion, "/"); i >= 0 {
+			group = apiVersion[:i]
+		}
+		if !isBuiltinGroup(group) {
+			return nil
+		}
+		return []string{fmt.Sprintf("%s %s is not served by Kubernetes %s", apiVersion, kind, v.version)}
+	}
+
+	validator, err := v.schemaValidator(name)
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	result := validator.Validate(obj)
+	var problems []string
+	for _, err := range result.Errors {
+		problems = append(problems, fmt.Sprintf("%s %s: %s", kind, resourceName(obj), err))
+	}
+	sort.Strings(problems)
+	return problems
+}
+
+// schemaValidator returns the validator of a definition, with its references
+// expanded once as resolving them on every validation is slow
+func (v *Validator) schemaValidator(name string) (*validate.SchemaValidator, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if validator, ok := v.validators[name]; ok {
+		return validator, nil
+	}
+
+	definition := v.doc.Definitions[name]
+	if err := spec.ExpandSchema(&definition, v.doc, nil); err != nil {
+		return nil, errors.Wrapf(err, "failed to expand the schema of %s", name)
+	}
+
+	validator := validate.NewSchemaValidator(&definition, nil, "", strfmt.Default)
+	v.validators[name] = validator
+	return validator, nil
+}
+
+func isBuiltinGroup(group string) bool {
+	for _, g := range builtinGroups {
+		if g == group {
+			return true
+		}
+	}
+	return false
+}
+
+func resourceName(obj map[string]interface{}) string {
+	if metadata, ok := obj["metadata"].(map[string]interface{}); ok {
+		if name, ok := metadata["name"].(string); ok {
+			return fmt.Sprintf("%q", name)
+		}
+	}
+	return "without a name"
+}
+
+// toJSON converts what YAML decodes to what JSON decodes, so that the
+// validator can walk it. Null fields are left out as Kubernetes treats them
+// as unset.
+func toJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		obj := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			if item != nil {
+				obj[fmt.Sprint(key)] = toJSON(item)
+			}
+		}
+		return obj
+	case []interface{}:
+		list := make([]interface{}, len(v))
+		for i, item := range v {
+			list[i] = toJSON(item)
+		}
+		return list
+	default:
+		return v
+	}
+}
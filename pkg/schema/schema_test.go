@@ -0,0 +1,123 @@
+package schema_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"deploy-wizard/pkg/schema"
+)
+
+const deployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app1
+spec:
+  replicas: 2
+  selector:
+    matchLabels:
+      app: app1
+  template:
+    metadata:
+      labels:
+        app: app1
+    spec:
+      containers:
+      - name: app1
+        image: nginx:1.19
+        imagePullPolicy:
+        ports:
+        - containerPort: 8080
+        resources:
+          limits:
+            cpu: 1
+            memory: 512Mi
+`
+
+func newValidator(t *testing.T, version string) *schema.Validator {
+	v, err := schema.NewValidator(version)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func assertProblems(t *testing.T, problems []string, expected ...string) {
+	if len(problems) != len(expected) {
+		t.Errorf("expected %d problems, got %v", len(expected), problems)
+		return
+	}
+	for i, problem := range problems {
+		if !strings.Contains(problem, expected[i]) {
+			t.Errorf("expected %q in %q", expected[i], problem)
+		}
+	}
+}
+
+func TestVersions(t *testing.T) {
+	versions := schema.Versions()
+	found := false
+	for _, version := range versions {
+		found = found || version == schema.DefaultVersion
+		newValidator(t, version)
+	}
+	if !found {
+		t.Errorf("expected the default version %s in %v", schema.DefaultVersion, versions)
+	}
+
+	if _, err := schema.NewValidator("1.0"); err == nil {
+		t.Error("expected an error for a version without schemas")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	v := newValidator(t, schema.DefaultVersion)
+
+	assertProblems(t, v.Validate(deployment))
+	assertProblems(t, v.Validate(strings.Replace(deployment, "replicas: 2", `replicas: "2"`, 1)),
+		`Deployment "app1": spec.replicas in body must be of type integer`)
+	assertProblems(t, v.Validate(strings.Replace(deployment, "  replicas: 2", "  replica: 2", 1)),
+		`Deployment "app1": spec.replica in body is a forbidden property`)
+	assertProblems(t, v.Validate(strings.Replace(deployment, "      - name: app1\n", "      - \n", 1)),
+		`Deployment "app1": spec.template.spec.containers.name in body is required`)
+	assertProblems(t, v.Validate("kind: Deployment\n"), "the resource has no apiVersion or kind")
+	assertProblems(t, v.Validate("apiVersion: v1\nkind: [\n"), "the file is not valid YAML")
+}
+
+func TestValidateMultipleResources(t *testing.T) {
+	v := newValidator(t, schema.DefaultVersion)
+
+	manifest := deployment + "---\napiVersion: v1\nkind: Service\nmetadata:\n  name: app1\nspec:\n  ports:\n  - port: http\n"
+	assertProblems(t, v.Validate(manifest), `Service "app1": spec.ports.port in body must be of type integer`)
+}
+
+func TestValidateVersion(t *testing.T) {
+	ingress := "apiVersion: extensions/v1beta1\nkind: Ingress\nmetadata:\n  name: app1\n"
+
+	assertProblems(t, newValidator(t, "1.19").Validate(ingress))
+	assertProblems(t, newValidator(t, "1.22").Validate(ingress), "extensions/v1beta1 Ingress is not served by Kubernetes 1.22")
+
+	if v := newValidator(t, "1.22"); !v.Has("networking.k8s.io/v1", "Ingress") || v.Has("extensions/v1beta1", "Ingress") {
+		t.Error("expected Kubernetes 1.22 to serve networking.k8s.io/v1 Ingresses only")
+	}
+}
+
+func TestValidateCustomResources(t *testing.T) {
+	v := newValidator(t, schema.DefaultVersion)
+
+	assertProblems(t, v.Validate("apiVersion: argoproj.io/v1alpha1\nkind: Application\nspec:\n  anything: true\n"))
+}
+
+func TestValidateConcurrent(t *testing.T) {
+	v := newValidator(t, schema.DefaultVersion)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assertProblems(t, v.Validate(deployment))
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,30 @@
+// Code generated by gen.go from the _templates directory. DO NOT EDIT.
+
+package templates
+
+var files = map[string]string{
+	"argocd-application.yaml":                   "apiVersion: argoproj.io/v1alpha1\nkind: Application\nmetadata:\n  name: {{.App.Metadata.Name | scalar}}\n  namespace: argocd\n  {{- with .App.Spec.Destination.Finalizers }}\n  finalizers:\n  {{- range . }}\n  - {{. | scalar}}\n  {{- end }}\n  {{- end }}\nspec:\n  destination:\n    namespace: {{.App.Metadata.Namespace | scalar}}\n    {{- if .Cluster.Name }}\n    name: {{.Cluster.Name | scalar}}\n    {{- else }}\n    server: {{.Cluster.Server | scalar}}\n    {{- end }}\n  project: {{.Project | scalar}}\n  source:\n    path: {{.Path | scalar}}\n    {{- with .ValueFiles }}\n    helm:\n      valueFiles:\n      {{- range . }}\n      - {{. | scalar}}\n      {{- end }}\n    {{- end }}\n    repoURL: {{.App.Spec.Destination.URL | scalar}}\n    targetRevision: {{.App.Spec.Destination.TargetRevision | scalar}}\n  {{- with .App.Spec.Destination.SyncPolicy }}\n  {{- if or .Automated .SyncOptions .Retry }}\n  syncPolicy:\n    {{- with .Automated }}\n    automated:\n      prune: {{.Prune}}\n      selfHeal: {{.SelfHeal}}\n    {{- end }}\n    {{- with .SyncOptions }}\n    syncOptions:\n    {{- range . }}\n    - {{. | scalar}}\n    {{- end }}\n    {{- end }}\n    {{- with .Retry }}\n    retry:\n      limit: {{.Limit}}\n      {{- with .Backoff }}\n      backoff:\n        {{- if .Duration }}\n        duration: {{.Duration | scalar}}\n        {{- end }}\n        {{- if .Factor }}\n        factor: {{.Factor}}\n        {{- end }}\n        {{- if .MaxDuration }}\n        maxDuration: {{.MaxDuration | scalar}}\n        {{- end }}\n      {{- end }}\n    {{- end }}\n  {{- end }}\n  {{- end }}\n  {{- with .App.Spec.Destination.IgnoreDifferences }}\n  ignoreDifferences:\n  {{- range . }}\n  - kind: {{.Kind | scalar}}\n    {{- if .Group }}\n    group: {{.Group | scalar}}\n    {{- end }}\n    {{- if .Name }}\n    name: {{.Name | scalar}}\n    {{- end }}\n    jsonPointers:\n    {{- range .JSONPointers }}\n    - {{. | scalar}}\n    {{- end }}\n  {{- end }}\n  {{- end }}\n",
+	"argocd-appproject.yaml":                    "apiVersion: argoproj.io/v1alpha1\nkind: AppProject\nmetadata:\n  name: {{.Project | scalar}}\n  namespace: argocd\nspec:\n  description: {{printf \"Kruise applications of team %s\" .App.Metadata.Labels.Team | scalar}}\n  sourceRepos:\n  {{- range .SourceRepos }}\n  - {{. | scalar}}\n  {{- end }}\n  destinations:\n  {{- range .Destinations }}\n  - namespace: {{.Namespace | scalar}}\n    {{- if .Name }}\n    name: {{.Name | scalar}}\n    {{- else }}\n    server: {{.Server | scalar}}\n    {{- end }}\n  {{- end }}\n  clusterResourceWhitelist:\n  - group: \"\"\n    kind: Namespace\n",
+	"configmap.yaml":                            "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  labels:\n    app: {{.App.Metadata.Name | scalar}}\n    release: {{.App.Metadata.Labels.Version | scalar}}\n  name: {{.ConfigMap.Name | scalar}}\ndata:\n  data: {{ .ConfigMap.Data | literal 4 }}\n",
+	"deployment-patch.yaml":                     "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: {{.Service.Name | scalar}}\nspec:\n  {{- if .Replicas }}\n  replicas: {{.Replicas}}\n  {{- end }}\n  {{- if .Containers }}\n  template:\n    spec:\n      containers:\n      {{- range .Containers }}\n      - name: {{.Name | scalar}}\n        {{- with .Resources }}\n        resources:\n          {{- with .Requests }}\n          requests:\n            {{- if .CPU }}\n            cpu: {{.CPU | scalar}}\n            {{- end }}\n            {{- if .Memory }}\n            memory: {{.Memory | scalar}}\n            {{- end }}\n          {{- end }}\n          {{- with .Limits }}\n          limits:\n            {{- if .CPU }}\n            cpu: {{.CPU | scalar}}\n            {{- end }}\n            {{- if .Memory }}\n            memory: {{.Memory | scalar}}\n            {{- end }}\n          {{- end }}\n        {{- end }}\n      {{- end }}\n  {{- end }}\n",
+	"deployment.yaml":                           "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: {{.Service.Name | scalar}}\n  labels:\n    app: {{.App.Metadata.Name | scalar}}\n    component: {{.Service.Name | scalar}}\n    release: {{.App.Metadata.Labels.Version | scalar}}\nspec:\n  replicas: {{.Replicas}}\n  selector:\n    matchLabels:\n      app: {{.App.Metadata.Name | scalar}}\n      component: {{.Service.Name | scalar}}\n  strategy:\n    type: Recreate\n  template:\n    metadata:\n      labels:\n        app: {{.App.Metadata.Name | scalar}}\n        component: {{.Service.Name | scalar}}\n        release: {{.App.Metadata.Labels.Version | scalar}}\n    spec:\n      affinity:\n        podAntiAffinity:\n          preferredDuringSchedulingIgnoredDuringExecution:\n          - podAffinityTerm:\n              labelSelector:\n                matchLabels:\n                  app: {{.App.Metadata.Name | scalar}}\n                  component: {{.Service.Name | scalar}}\n                  release: {{.App.Metadata.Labels.Version | scalar}}\n              topologyKey: kubernetes.io/hostname\n            weight: 100\n      volumes:\n      {{- range .ConfigMapNames }}\n      - name: {{. | scalar}}\n        configMap:\n          name: {{. | scalar}}\n      {{- end }}\n      {{- range .PersistentVolumeNames }}\n      - name: {{. | scalar}}\n        persistentVolumeClaim:\n          claimName: {{. | scalar}}\n      {{- end }}\n      containers:\n      {{- range .Containers }}\n      - name: {{.Name | scalar}}\n        image: {{printf \"%s:%s\" .Image .ImageTag | scalar}}\n        imagePullPolicy: {{.ImagePullPolicy | scalar}}\n        {{- if .Command }}\n        command: {{commandArgs .Command | toJson}}\n        {{- end }}\n        {{- with .Resources }}\n        resources:\n          {{- with .Requests }}\n          requests:\n            {{- if .CPU }}\n            cpu: {{.CPU | scalar}}\n            {{- end }}\n            {{- if .Memory }}\n            memory: {{.Memory | scalar}}\n            {{- end }}\n          {{- end }}\n          {{- with .Limits }}\n          limits:\n            {{- if .CPU }}\n            cpu: {{.CPU | scalar}}\n            {{- end }}\n            {{- if .Memory }}\n            memory: {{.Memory | scalar}}\n            {{- end }}\n          {{- end }}\n        {{- end }}\n        {{- with .ReadinessProbe }}\n        readinessProbe:\n          {{- if .Path }}\n          httpGet:\n            path: {{.Path | scalar}}\n            port: {{.PortName | scalar}}\n          {{- else }}\n          tcpSocket:\n            port: {{.PortName | scalar}}\n          {{- end }}\n          {{- if .InitialDelaySeconds }}\n          initialDelaySeconds: {{.InitialDelaySeconds}}\n          {{- end }}\n          {{- if .PeriodSeconds }}\n          periodSeconds: {{.PeriodSeconds}}\n          {{- end }}\n        {{- end }}\n        {{- with .LivenessProbe }}\n        livenessProbe:\n          {{- if .Path }}\n          httpGet:\n            path: {{.Path | scalar}}\n            port: {{.PortName | scalar}}\n          {{- else }}\n          tcpSocket:\n            port: {{.PortName | scalar}}\n          {{- end }}\n          {{- if .InitialDelaySeconds }}\n          initialDelaySeconds: {{.InitialDelaySeconds}}\n          {{- end }}\n          {{- if .PeriodSeconds }}\n          periodSeconds: {{.PeriodSeconds}}\n          {{- end }}\n        {{- end }}\n        volumeMounts:\n        {{- range .Volumes }}\n        - mountPath: {{.MountPath | scalar}}\n          name: {{.Name | scalar}}\n          readOnly: {{.ReadOnly}}\n          {{- if .SubPath }}\n          subPath: {{.SubPath | scalar}}\n          {{- end }}\n        {{- end}}\n        ports:\n        {{- range $containerPort := .PortNames }}\n        {{- range $servicePort := $.Service.Ports }}\n        {{- if eq $containerPort $servicePort.Name}}\n        - name: {{$servicePort.Name | scalar}}\n          {{- if $servicePort.TargetPort }}\n          containerPort: {{$servicePort.TargetPort}}\n          {{- else }}\n          containerPort: {{$servicePort.Port}}\n          {{- end }}\n          protocol: {{$servicePort.Protocol | scalar}}\n        {{- end }}\n        {{- end }}\n        {{- end }}\n      {{- end }}\n",
+	"flux-gitrepository.yaml":                   "apiVersion: source.toolkit.fluxcd.io/v1\nkind: GitRepository\nmetadata:\n  name: {{.App.Metadata.Name | scalar}}\n  namespace: flux-system\nspec:\n  interval: 1m\n  url: {{.App.Spec.Destination.URL | scalar}}\n  {{- with .Ref }}\n  ref:\n    {{- if .Commit }}\n    commit: {{.Commit | scalar}}\n    {{- else if .Name }}\n    name: {{.Name | scalar}}\n    {{- else }}\n    branch: {{.Branch | scalar}}\n    {{- end }}\n  {{- end }}\n",
+	"flux-helmrelease.yaml":                     "apiVersion: helm.toolkit.fluxcd.io/v2\nkind: HelmRelease\nmetadata:\n  name: {{.App.Metadata.Name | scalar}}\n  namespace: flux-system\nspec:\n  interval: 5m\n  {{- if .Suspend }}\n  suspend: true\n  {{- end }}\n  chart:\n    spec:\n      chart: {{.Path | scalar}}\n      reconcileStrategy: Revision\n      sourceRef:\n        kind: GitRepository\n        name: {{.App.Metadata.Name | scalar}}\n      {{- with .ValuesFiles }}\n      valuesFiles:\n      {{- range . }}\n      - {{. | scalar}}\n      {{- end }}\n      {{- end }}\n  releaseName: {{.App.Metadata.Name | scalar}}\n  targetNamespace: {{.App.Metadata.Namespace | scalar}}\n  {{- if .KubeConfigSecret }}\n  kubeConfig:\n    secretRef:\n      name: {{.KubeConfigSecret | scalar}}\n  {{- end }}\n",
+	"flux-kustomization.yaml":                   "apiVersion: kustomize.toolkit.fluxcd.io/v1\nkind: Kustomization\nmetadata:\n  name: {{.App.Metadata.Name | scalar}}\n  namespace: flux-system\nspec:\n  interval: 5m\n  {{- if .RetryInterval }}\n  retryInterval: {{.RetryInterval | scalar}}\n  {{- end }}\n  path: {{.Path | scalar}}\n  prune: {{.Prune}}\n  {{- if .Suspend }}\n  suspend: true\n  {{- end }}\n  sourceRef:\n    kind: GitRepository\n    name: {{.App.Metadata.Name | scalar}}\n  targetNamespace: {{.App.Metadata.Namespace | scalar}}\n  {{- if .KubeConfigSecret }}\n  kubeConfig:\n    secretRef:\n      name: {{.KubeConfigSecret | scalar}}\n  {{- end }}\n",
+	"helm/Chart.yaml":                           "apiVersion: v2\nname: {{.Metadata.Name | scalar}}\ndescription: {{printf \"Kruise application %s of team %s\" .Metadata.Name .Metadata.Labels.Team | scalar}}\ntype: application\nversion: 0.1.0\nappVersion: {{.Metadata.Labels.Version | quote}}\n",
+	"helm/templates/_helpers.tpl":               "{{/*\nLabels of all resources of the application\n*/}}\n{{- define \"app.labels\" -}}\napp: {{ .Values.app.name | quote }}\nrelease: {{ .Values.app.version | quote }}\n{{- end }}\n",
+	"helm/templates/configmap.yaml":             "{{- range .Values.configMaps }}\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  labels:\n    {{- include \"app.labels\" $ | nindent 4 }}\n  name: {{ .name | quote }}\ndata:\n  data: {{ .data | quote }}\n{{- end }}\n",
+	"helm/templates/deployment.yaml":            "{{- range $name, $component := .Values.components }}\n---\napiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: {{ $name | quote }}\n  labels:\n    component: {{ $name | quote }}\n    {{- include \"app.labels\" $ | nindent 4 }}\nspec:\n  replicas: {{ $component.replicas }}\n  selector:\n    matchLabels:\n      app: {{ $.Values.app.name | quote }}\n      component: {{ $name | quote }}\n  strategy:\n    type: Recreate\n  template:\n    metadata:\n      labels:\n        component: {{ $name | quote }}\n        {{- include \"app.labels\" $ | nindent 8 }}\n    spec:\n      affinity:\n        podAntiAffinity:\n          preferredDuringSchedulingIgnoredDuringExecution:\n          - podAffinityTerm:\n              labelSelector:\n                matchLabels:\n                  component: {{ $name | quote }}\n                  {{- include \"app.labels\" $ | nindent 18 }}\n              topologyKey: kubernetes.io/hostname\n            weight: 100\n      {{- with $component.volumes }}\n      volumes:\n      {{- range .configMaps }}\n      - name: {{ . | quote }}\n        configMap:\n          name: {{ . | quote }}\n      {{- end }}\n      {{- range .persistentVolumeClaims }}\n      - name: {{ . | quote }}\n        persistentVolumeClaim:\n          claimName: {{ . | quote }}\n      {{- end }}\n      {{- end }}\n      containers:\n      {{- range $containerName, $container := $component.containers }}\n      - name: {{ $containerName | quote }}\n        image: {{ printf \"%s:%s\" $container.image $container.imageTag | quote }}\n        imagePullPolicy: {{ $container.imagePullPolicy | quote }}\n        {{- with $container.command }}\n        command: {{ toJson . }}\n        {{- end }}\n        {{- with $container.resources }}\n        resources:\n          {{- toYaml . | nindent 10 }}\n        {{- end }}\n        {{- with $container.readinessProbe }}\n        readinessProbe:\n          {{- toYaml . | nindent 10 }}\n        {{- end }}\n        {{- with $container.livenessProbe }}\n        livenessProbe:\n          {{- toYaml . | nindent 10 }}\n        {{- end }}\n        {{- with $container.volumeMounts }}\n        volumeMounts:\n          {{- toYaml . | nindent 8 }}\n        {{- end }}\n        {{- with $container.ports }}\n        ports:\n          {{- toYaml . | nindent 8 }}\n        {{- end }}\n      {{- end }}\n{{- end }}\n",
+	"helm/templates/ingress.yaml":               "{{- range $name, $component := .Values.components }}\n{{- range $key, $ingress := $component.ingresses }}\n---\napiVersion: {{ $.Values.apiVersions.Ingress }}\nkind: Ingress\nmetadata:\n  annotations:\n    kubernetes.io/ingress.class: \"nginx\"\n  labels:\n    component: {{ $name | quote }}\n    {{- include \"app.labels\" $ | nindent 4 }}\n  name: {{ printf \"%s-%s\" $name ($key | replace \".\" \"-\") | quote }}\nspec:\n  rules:\n  - host: {{ $ingress.host | quote }}\n    http:\n      paths:\n      {{- range $ingress.paths }}\n      - backend:\n          {{- if eq $.Values.apiVersions.Ingress \"networking.k8s.io/v1\" }}\n          service:\n            name: {{ $name | quote }}\n            port:\n              name: {{ .portName | quote }}\n        pathType: ImplementationSpecific\n          {{- else }}\n          serviceName: {{ $name | quote }}\n          servicePort: {{ .portName | quote }}\n          {{- end }}\n        path: {{ .path | quote }}\n      {{- end }}\n{{- end }}\n{{- end }}\n",
+	"helm/templates/persistentvolumeclaim.yaml": "{{- range .Values.persistentVolumes }}\n---\napiVersion: v1\nkind: PersistentVolumeClaim\nmetadata:\n  labels:\n    {{- include \"app.labels\" $ | nindent 4 }}\n  name: {{ .name | quote }}\nspec:\n  accessModes:\n  - {{ .accessMode | quote }}\n  resources:\n    requests:\n      storage: {{ .capacity }}Gi\n  storageClassName: {{ .storageClassName | quote }}\n{{- end }}\n",
+	"helm/templates/service.yaml":               "{{- range $name, $component := .Values.components }}\n---\napiVersion: v1\nkind: Service\nmetadata:\n  labels:\n    component: {{ $name | quote }}\n    {{- include \"app.labels\" $ | nindent 4 }}\n  name: {{ $name | quote }}\nspec:\n  ports:\n  {{- range $component.service.ports }}\n  - name: {{ .name | quote }}\n    port: {{ .port }}\n    protocol: {{ .protocol | quote }}\n    {{- if .targetPort }}\n    targetPort: {{ .targetPort }}\n    {{- end }}\n  {{- end }}\n  selector:\n    app: {{ $.Values.app.name | quote }}\n    component: {{ $name | quote }}\n  type: {{ $component.service.type | quote }}\n{{- end }}\n",
+	"helm/templates/serviceaccount.yaml":        "apiVersion: v1\nkind: ServiceAccount\nmetadata:\n  labels:\n    {{- include \"app.labels\" . | nindent 4 }}\n  name: {{ .Values.app.name | quote }}\n",
+	"ingress-fanout.yaml":                       "apiVersion: extensions/v1beta1\nkind: Ingress\nmetadata:\n  annotations:\n    nginx.ingress.kubernetes.io/rewrite-target: /\n    kubernetes.io/ingress.class: {{.applicationName}}-ingress\n  name: {{.applicationName}}-ingress\nspec:\n  rules:\n{{- range .services }}\n  - host: {{.hostFQDN}}\n    http:\n      paths:\n      - backend:\n          serviceName: {{.serviceName}}\n          servicePort: {{.servicePort}}\n{{- if .servicePath }}\n        path: {{.servicePath}}\n{{- end }}\n{{- end }}\n",
+	"ingress-host.yaml":                         "apiVersion: extensions/v1beta1\nkind: Ingress\nmetadata:\n  name: {{application-name-ingress}}\n  annotations:\n       kubernetes.io/ingress.class: {{application-name-ingress}}\nspec:\n  rules:\n  - host: {{host-1-fqdn}}\n    http:\n      paths:\n      - backend:\n          serviceName: {{service-1-name}}\n          servicePort: {{service-1-port}}\n  - host: {{host-2-fqdn}}\n    http:\n      paths:\n      - backend:\n          serviceName: {{service-2-name}}\n          servicePort: {{service-2-port}}\n",
+	"ingress.yaml":                              "apiVersion: {{.APIVersions.Ingress}}\nkind: Ingress\nmetadata:\n  annotations:\n    kubernetes.io/ingress.class: \"nginx\"\n  labels:\n    component: {{.Service.Name | scalar}}\n    app: {{.App.Metadata.Name | scalar}}\n    release: {{.App.Metadata.Labels.Version | scalar}}\n  name: {{.Service.Name | scalar}}\nspec:\n  rules:\n  - host: {{.Ingress.Host | scalar}}\n    http:\n      paths:\n      - backend:\n{{- if eq .APIVersions.Ingress \"networking.k8s.io/v1\" }}\n          service:\n            name: {{.Service.Name | scalar}}\n            port:\n              name: {{.IngressPath.PortName | scalar}}\n        path: {{.IngressPath.Path | scalar}}\n        pathType: ImplementationSpecific\n{{- else }}\n          serviceName: {{.Service.Name | scalar}}\n          servicePort: {{.IngressPath.PortName | scalar}}\n        path: {{.IngressPath.Path | scalar}}\n{{- end }}\n",
+	"kustomization.yaml":                        "{{- if .Namespace -}}\nnamespace: {{.Namespace | scalar}}\n{{ end -}}\n{{- if .NamePrefix -}}\nnamePrefix: {{.NamePrefix | scalar}}\n{{ end -}}\n{{- if .NameSuffix -}}\nnameSuffix: {{.NameSuffix | scalar}}\n{{ end -}}\n{{- with .CommonLabels -}}\ncommonLabels:\n{{- range $key, $value := . }}\n  {{$key | scalar}}: {{printf \"%q\" $value}}\n{{- end }}\n{{ end -}}\n{{- with .CommonAnnotations -}}\ncommonAnnotations:\n{{- range $key, $value := . }}\n  {{$key | scalar}}: {{printf \"%q\" $value}}\n{{- end }}\n{{ end -}}\nresources:\n{{- range .Resources }}\n- {{. | scalar}}\n{{- end }}\n{{- with .Patches }}\npatches:\n{{- range . }}\n- path: {{. | scalar}}\n{{- end }}\n{{- end }}\n{{- with .Images }}\nimages:\n{{- range . }}\n- name: {{.Name | scalar}}\n  {{- if .NewName }}\n  newName: {{.NewName | scalar}}\n  {{- end }}\n  {{- if .NewTag }}\n  newTag: {{printf \"%q\" .NewTag}}\n  {{- end }}\n  {{- if .Digest }}\n  digest: {{.Digest | scalar}}\n  {{- end }}\n{{- end }}\n{{- end }}\n{{- with .ConfigMapGenerator }}\nconfigMapGenerator:\n{{- range . }}\n- name: {{.Name | scalar}}\n  {{- if .Behavior }}\n  behavior: {{.Behavior | scalar}}\n  {{- end }}\n  {{- with .Literals }}\n  literals:\n  {{- range . }}\n  - {{printf \"%q\" .}}\n  {{- end }}\n  {{- end }}\n  {{- with .Files }}\n  files:\n  {{- range . }}\n  - {{. | scalar}}\n  {{- end }}\n  {{- end }}\n  {{- with .Envs }}\n  envs:\n  {{- range . }}\n  - {{. | scalar}}\n  {{- end }}\n  {{- end }}\n{{- end }}\n{{- end }}\n{{- with .SecretGenerator }}\nsecretGenerator:\n{{- range . }}\n- name: {{.Name | scalar}}\n  {{- if .Type }}\n  type: {{.Type | scalar}}\n  {{- end }}\n  {{- if .Behavior }}\n  behavior: {{.Behavior | scalar}}\n  {{- end }}\n  {{- with .Literals }}\n  literals:\n  {{- range . }}\n  - {{printf \"%q\" .}}\n  {{- end }}\n  {{- end }}\n  {{- with .Files }}\n  files:\n  {{- range . }}\n  - {{. | scalar}}\n  {{- end }}\n  {{- end }}\n  {{- with .Envs }}\n  envs:\n  {{- range . }}\n  - {{. | scalar}}\n  {{- end }}\n  {{- end }}\n{{- end }}\n{{- end }}\n",
+	"persistentvolumeclaim.yaml":                "apiVersion: v1\nkind: PersistentVolumeClaim\nmetadata:\n  labels:\n    app: {{.App.Metadata.Name | scalar}}\n    release: {{.App.Metadata.Labels.Version | scalar}}\n  name: {{.PersistentVolume.Name | scalar}}\nspec:\n  accessModes:\n  - {{.PersistentVolume.AccessMode | scalar}}\n  resources:\n    requests:\n      storage: {{.PersistentVolume.Capacity}}Gi\n  storageClassName: {{.PersistentVolume.StorageClassName | scalar}}\n",
+	"pvc.yaml":                                  "apiVersion: v1\nkind: PersistentVolumeClaim\nmetadata:\n  name: {{persistent-volume-claim-name-pvc}}\nspec:\n  accessModes:\n  - [[ReadWriteOnce,ReadOnlyMany,ReadWriteMany]]\n  resources:\n    requests:\n      storage: {{size-in-gigabytes}}\n  storageClassName: {{available storage classes}}\n",
+	"service-account.yaml":                      "apiVersion: v1\nkind: ServiceAccount\nmetadata:\n  labels:\n    app: {{.Metadata.Name | scalar}}\n    release: {{.Metadata.Labels.Version | scalar}}\n  name: {{.Metadata.Name | scalar}}\n",
+	"service.yaml":                              "apiVersion: v1\nkind: Service\nmetadata:\n  labels:\n    component: {{.Service.Name | scalar}}\n    app: {{.App.Metadata.Name | scalar}}\n    release: {{.App.Metadata.Labels.Version | scalar}}\n  name: {{.Service.Name | scalar}}\nspec:\n  ports:\n  {{- range .Service.Ports }}\n  - name: {{.Name | scalar}}\n    port: {{.Port}}\n    protocol: {{.Protocol | scalar}}\n    {{- if .TargetPort }}\n    targetPort: {{.TargetPort}}\n    {{- end }}\n  {{- end }}\n  selector:\n    app: {{.App.Metadata.Name | scalar}}\n    component: {{.Service.Name | scalar}}\n  type: {{.Service.Type | scalar}}\n",
+}
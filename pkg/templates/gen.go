@@ -0,0 +1,87 @@
+//go:build ignore
+// +build ignore
+
+// gen writes the templates of the _templates directory to embedded.go
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const templateDir = "../../_templates"
+
+var extensions = []string{".yaml", ".yml", ".tpl"}
+
+func main() {
+	files := map[string]string{}
+	err := filepath.Walk(templateDir, func(filename string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if filename != templateDir && strings.HasPrefix(info.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !isTemplate(filename) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(templateDir, filename)
+		if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = string(data)
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "// Code generated by gen.go from the _templates directory. DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package templates")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "var files = map[string]string{")
+	for _, name := range names {
+		fmt.Fprintf(&b, "%q: %q,\n", name, files[name])
+	}
+	fmt.Fprintln(&b, "}")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("embedded.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func isTemplate(filename string) bool {
+	for _, ext := range extensions {
+		if filepath.Ext(filename) == ext {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,18 @@
+// Package templates holds the default templates of the renderer, compiled
+// into the server from the _templates directory
+package templates
+
+//go:generate go run gen.go
+
+// Extensions are the extensions of the template files
+var Extensions = []string{".yaml", ".yml", ".tpl"}
+
+// Files returns the default templates keyed by their slash separated path
+// relative to the _templates directory
+func Files() map[string]string {
+	result := make(map[string]string, len(files))
+	for name, content := range files {
+		result[name] = content
+	}
+	return result
+}
@@ -0,0 +1,70 @@
+package templates_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"deploy-wizard/pkg/templates"
+)
+
+const templateDir = "../../_templates"
+
+func TestFilesUpToDate(t *testing.T) {
+	files := templates.Files()
+
+	err := filepath.Walk(templateDir, func(filename string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		isTemplate := false
+		for _, ext := range templates.Extensions {
+			isTemplate = isTemplate || filepath.Ext(filename) == ext
+		}
+		if !isTemplate {
+			return nil
+		}
+
+		rel, err := filepath.Rel(templateDir, filename)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		content, ok := files[name]
+		if !ok {
+			t.Errorf("%s is not embedded", name)
+		} else if content != string(data) {
+			t.Errorf("%s differs from the embedded template", name)
+		}
+		delete(files, name)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name := range files {
+		t.Errorf("%s is embedded but not in %s", name, templateDir)
+	}
+	if t.Failed() {
+		t.Log("run go generate ./pkg/templates")
+	}
+}
+
+func TestFilesCopy(t *testing.T) {
+	files := templates.Files()
+	for name := range files {
+		delete(files, name)
+	}
+
+	if len(templates.Files()) == 0 {
+		t.Error("expected Files to return a copy")
+	}
+}